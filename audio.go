@@ -0,0 +1,121 @@
+package annkesdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+func (c Connector) GetAudioChannels() (models.AudioChannelList, error) {
+	channels := models.AudioChannelList{}
+	err := c.makeGetRequest(audioChannelsPath, &channels)
+	return channels, err
+}
+
+func (c Connector) GetAudioChannel(channel int) (models.AudioChannel, error) {
+	audioChannel := models.AudioChannel{}
+	err := c.makeGetRequest(getAudioChannelPath(channel), &audioChannel)
+	return audioChannel, err
+}
+
+func (c Connector) UpdateAudioChannel(channel int, audioChannel models.AudioChannel) error {
+	return c.makeUpdateRequest(getAudioChannelPath(channel), audioChannel)
+}
+
+func (c Connector) GetTwoWayAudioChannels() (models.TwoWayAudioChannelList, error) {
+	channels := models.TwoWayAudioChannelList{}
+	err := c.makeGetRequest(twoWayAudioChannels, &channels)
+	return channels, err
+}
+
+func (c Connector) GetTwoWayAudioChannel(channel int) (models.TwoWayAudioChannel, error) {
+	audioChannel := models.TwoWayAudioChannel{}
+	err := c.makeGetRequest(getTwoWayAudioChannelPath(channel), &audioChannel)
+	return audioChannel, err
+}
+
+func (c Connector) UpdateTwoWayAudioChannel(channel int, audioChannel models.TwoWayAudioChannel) error {
+	return c.makeUpdateRequest(getTwoWayAudioChannelPath(channel), audioChannel)
+}
+
+func (c Connector) OpenTwoWayAudio(ctx context.Context, channel int) (string, error) {
+	session := models.TwoWayAudioSession{}
+	err := c.makeRequest(ctx, "PUT", getTwoWayAudioActionPath(channel, "open"), nil, &session)
+	return session.SessionID, err
+}
+
+func (c Connector) CloseTwoWayAudio(ctx context.Context, channel int) error {
+	return c.makeRequest(ctx, "PUT", getTwoWayAudioActionPath(channel, "close"), nil, nil)
+}
+
+func (c Connector) SendAudio(ctx context.Context, channel int, sessionID string, compression models.AudioCompression, audio io.Reader) error {
+	path := getTwoWayAudioActionPath(channel, "audioData")
+	if sessionID != "" {
+		path += "?sessionId=" + url.QueryEscape(sessionID)
+	}
+	rate := g711BytesPerSecond
+	if compression == models.AudioCompressionG726 {
+		rate = g726BytesPerSecond
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/octet-stream")
+	resp, err := c.makeStreamRequest(ctx, "PUT", path, header, newPacedReader(ctx, audio, rate))
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c Connector) PlayAudio(ctx context.Context, channel int, compression models.AudioCompression, audio io.Reader) (err error) {
+	sessionID, err := c.OpenTwoWayAudio(ctx, channel)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout*time.Second)
+		defer cancel()
+		if closeErr := c.CloseTwoWayAudio(closeCtx, channel); err == nil {
+			err = closeErr
+		}
+	}()
+	return c.SendAudio(ctx, channel, sessionID, compression, audio)
+}
+
+type pacedReader struct {
+	ctx   context.Context
+	r     io.Reader
+	rate  int
+	start time.Time
+	sent  int64
+}
+
+func newPacedReader(ctx context.Context, r io.Reader, rate int) *pacedReader {
+	return &pacedReader{ctx: ctx, r: r, rate: rate}
+}
+
+func (p *pacedReader) Read(b []byte) (int, error) {
+	if p.start.IsZero() {
+		p.start = time.Now()
+	}
+	if frame := p.rate / audioFramesPerSecond; len(b) > frame {
+		b = b[:frame]
+	}
+	due := p.start.Add(time.Duration(p.sent) * time.Second / time.Duration(p.rate))
+	if wait := time.Until(due); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-p.ctx.Done():
+			timer.Stop()
+			return 0, p.ctx.Err()
+		case <-timer.C:
+		}
+	}
+	n, err := p.r.Read(b)
+	p.sent += int64(n)
+	return n, err
+}
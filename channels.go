@@ -0,0 +1,47 @@
+package annkesdk
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+func (c Connector) GetChannel(channel int) (models.VideoInputChannel, error) {
+	inputChannel := models.VideoInputChannel{}
+	err := c.makeGetRequest(getInputChannelPath(channel), &inputChannel)
+	return inputChannel, err
+}
+
+func (c Connector) UpdateChannel(channel int, update models.ChannelUpdate) error {
+	inputChannel, err := c.GetChannel(channel)
+	if err != nil {
+		return err
+	}
+	if update.Name != nil {
+		inputChannel.Name = *update.Name
+	}
+	if update.Enabled != nil {
+		inputChannel.VideoInputEnabled = strconv.FormatBool(*update.Enabled)
+	}
+	return c.makeUpdateRequest(getInputChannelPath(channel), inputChannel)
+}
+
+func (c Connector) RenameChannels(names map[int]string) error {
+	channels := make([]int, 0, len(names))
+	for channel := range names {
+		channels = append(channels, channel)
+	}
+	sort.Ints(channels)
+
+	var errs []error
+	for _, channel := range channels {
+		name := names[channel]
+		if err := c.UpdateChannel(channel, models.ChannelUpdate{Name: &name}); err != nil {
+			errs = append(errs, fmt.Errorf("Error renaming channel %d %w", channel, err))
+		}
+	}
+	return errors.Join(errs...)
+}
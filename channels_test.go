@@ -0,0 +1,41 @@
+package annkesdk
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_RenameChannels(t *testing.T) {
+	var mu sync.Mutex
+	updates := map[string]string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			if r.URL.Path == "/ISAPI/System/Video/inputs/channels/3" {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, "mock-not-found")
+				return
+			}
+			fmt.Fprintf(w, "<VideoInputChannel><id>%s</id><videoInputEnabled>true</videoInputEnabled><name>old</name></VideoInputChannel>", r.URL.Path[len(inputChannelsPath)+1:])
+		case "PUT":
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			updates[r.URL.Path] = string(body)
+			mu.Unlock()
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	err := c.RenameChannels(map[int]string{1: "Lobby", 3: "Dock"})
+	assert.ErrorContains(t, err, "Error renaming channel 3")
+	assert.Equal(t, map[string]string{
+		"/ISAPI/System/Video/inputs/channels/1": "<VideoInputChannel><id>1</id><inputPort></inputPort><videoInputEnabled>true</videoInputEnabled><name>Lobby</name><videoFormat></videoFormat><resDesc></resDesc></VideoInputChannel>",
+	}, updates)
+}
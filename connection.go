@@ -1,6 +1,7 @@
 package annkesdk
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/xml"
@@ -10,19 +11,29 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/csrar/annkeSDK/models"
 )
 
 type Connector struct {
-	Host     string
-	User     string
-	Password string
-	Secure   bool
-	client   http.Client
+	Host           string
+	User           string
+	Password       string
+	Secure         bool
+	MaxConcurrency int
+	client         http.Client
 }
 
+type requestLimiter struct {
+	mu     sync.Mutex
+	active int
+	wake   chan struct{}
+}
+
+var hostLimiters sync.Map
+
 func NewConnector(host, user, password string, secure bool) (*Connector, error) {
 	cfg := Connector{
 		Host:     host,
@@ -53,6 +64,44 @@ func (cfg Connector) validateParameters() error {
 	return nil
 }
 
+type OnlineOptions struct {
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+func (c Connector) WaitOnline(ctx context.Context, opts OnlineOptions) error {
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultOnlineTimeout
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = defaultOnlineInterval
+	}
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+	for {
+		err := c.reconnect()
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Error waiting for %s to come online %w", c.Host, err)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c Connector) reconnect() error {
+	loginResponse, err := c.login()
+	if err != nil {
+		return err
+	}
+	return c.newSession(loginResponse)
+}
+
 func (c Connector) login() (models.LoginResponse, error) {
 	loginResponse := models.LoginResponse{}
 	req, err := c.prepareLoginRequest()
@@ -148,6 +197,49 @@ func generateRandom() int {
 	return rand.Intn(randomLenght)
 }
 
+func (c Connector) concurrency() int {
+	if c.MaxConcurrency > 0 {
+		return c.MaxConcurrency
+	}
+	return defaultConcurrency
+}
+
+func (c Connector) requestLimiter() *requestLimiter {
+	limiter, _ := hostLimiters.LoadOrStore(c.Host, &requestLimiter{})
+	return limiter.(*requestLimiter)
+}
+
+func (l *requestLimiter) acquire(ctx context.Context, limit int) (func(), error) {
+	for {
+		l.mu.Lock()
+		if l.active < limit {
+			l.active++
+			l.mu.Unlock()
+			return l.release, nil
+		}
+		if l.wake == nil {
+			l.wake = make(chan struct{})
+		}
+		wake := l.wake
+		l.mu.Unlock()
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (l *requestLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.active--
+	if l.wake != nil {
+		close(l.wake)
+		l.wake = nil
+	}
+}
+
 func (c Connector) getProtocol() string {
 	protocol := "http"
 	if c.Secure {
@@ -1,12 +1,14 @@
 package annkesdk
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/csrar/annkeSDK/models"
 	"github.com/stretchr/testify/assert"
@@ -287,3 +289,28 @@ func TestConnector_newSesion(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestLimiter(t *testing.T) {
+	limiter := Connector{Host: "mock-limiter"}.requestLimiter()
+	assert.Same(t, limiter, Connector{Host: "mock-limiter", MaxConcurrency: 8}.requestLimiter())
+
+	release, err := limiter.acquire(context.Background(), 1)
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = limiter.acquire(ctx, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	second, err := limiter.acquire(context.Background(), 2)
+	assert.Nil(t, err)
+	acquired := make(chan struct{})
+	go func() {
+		third, err := limiter.acquire(context.Background(), 2)
+		assert.Nil(t, err)
+		third()
+		close(acquired)
+	}()
+	release()
+	<-acquired
+	second()
+}
@@ -1,27 +1,243 @@
 package annkesdk
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
 
 const (
-	timeout      = 5
-	randomLenght = 100000000
+	timeout            = 5
+	randomLenght       = 100000000
+	defaultConcurrency = 4
 
 	loginPath           = "/ISAPI/Security/sessionLogin/capabilities"
 	sessionPath         = "/ISAPI/Security/sessionLogin"
 	inputChannelsPath   = "/ISAPI/System/Video/inputs/channels"
+	inputChannelPath    = "/ISAPI/System/Video/inputs/channels/%d"
 	motionDetectionPath = "/ISAPI/System/Video/inputs/channels/%d/motionDetection"
-	motionSchedule      = "/ISAPI/Event/schedules/motionDetections/VMD_video%d"
-	eventTrigger        = "/ISAPI/Event/triggers/VMD-%d"
+	eventSchedule       = "/ISAPI/Event/schedules/"
+	eventTriggersPath   = "/ISAPI/Event/triggers"
+	eventTrigger        = "/ISAPI/Event/triggers/%s-%d"
+	tamperDetectionPath = "/ISAPI/System/Video/inputs/channels/%d/tamperDetection"
+	videoLossPath       = "/ISAPI/System/Video/inputs/channels/%d/videoLoss"
+	overlaysPath        = "/ISAPI/System/Video/inputs/channels/%d/overlays"
+	privacyMaskPath     = "/ISAPI/System/Video/inputs/channels/%d/privacyMask"
+	privacyMaskCapsPath = "/ISAPI/System/Video/inputs/channels/%d/privacyMask/capabilities"
+	channelsStatusPath  = "/ISAPI/System/Video/inputs/channels/status"
+	regionEntrancePath  = "/ISAPI/Smart/regionEntrance/%d"
+	regionExitingPath   = "/ISAPI/Smart/regionExiting/%d"
+	ioInputsPath        = "/ISAPI/System/IO/inputs"
+	ioInputPath         = "/ISAPI/System/IO/inputs/%d"
+	ioOutputsPath       = "/ISAPI/System/IO/outputs"
+	ioOutputPath        = "/ISAPI/System/IO/outputs/%d"
+	ioOutputTriggerPath = "/ISAPI/System/IO/outputs/%d/trigger"
+	ioStatusPath        = "/ISAPI/System/IO/status"
+	streamingChannels   = "/ISAPI/Streaming/channels"
+	streamingChannel    = "/ISAPI/Streaming/channels/%d"
+	snapshotPath        = "/ISAPI/Streaming/channels/%d/picture"
+	adminAccessesPath   = "/ISAPI/Security/adminAccesses"
+	networkInterfaces   = "/ISAPI/System/Network/interfaces"
+	networkInterface    = "/ISAPI/System/Network/interfaces/%d"
+	imageChannelPath    = "/ISAPI/Image/channels/%d"
+	ptzPath             = "/ISAPI/PTZCtrl/channels/%d/%s"
+	ptzItemPath         = "/ISAPI/PTZCtrl/channels/%d/%s/%d"
+	audioChannelsPath   = "/ISAPI/System/Audio/channels"
+	audioChannelPath    = "/ISAPI/System/Audio/channels/%d"
+	twoWayAudioChannels = "/ISAPI/System/TwoWayAudio/channels"
+	twoWayAudioChannel  = "/ISAPI/System/TwoWayAudio/channels/%d"
+	twoWayAudioAction   = "/ISAPI/System/TwoWayAudio/channels/%d/%s"
+	searchPath          = "/ISAPI/ContentMgmt/search"
+	downloadPath        = "/ISAPI/ContentMgmt/download"
+	recordTrackPath     = "/ISAPI/ContentMgmt/record/tracks/%d"
+	dailyDistribution   = "/ISAPI/ContentMgmt/record/tracks/%d/dailyDistribution"
+	storagePath         = "/ISAPI/ContentMgmt/Storage"
+	hddActionPath       = "/ISAPI/ContentMgmt/Storage/hdd/%s/%s"
+	quotasPath          = "/ISAPI/ContentMgmt/Storage/quota"
+	quotaPath           = "/ISAPI/ContentMgmt/Storage/quota/%d"
+	deviceInfoPath      = "/ISAPI/System/deviceInfo"
+	updateFirmwarePath  = "/ISAPI/System/updateFirmware"
+	upgradeStatusPath   = "/ISAPI/System/upgradeStatus"
+	rebootPath          = "/ISAPI/System/reboot"
+	factoryResetPath    = "/ISAPI/System/factoryReset?mode=%s"
+	timePath            = "/ISAPI/System/time"
+	ntpServersPath      = "/ISAPI/System/time/ntpServers"
+	ntpServerPath       = "/ISAPI/System/time/ntpServers/%d"
+	ptzPresets          = "presets"
+	ptzPatrols          = "patrols"
+	ptzPatterns         = "patterns"
+	imageSettingPath    = "/ISAPI/Image/channels/%d/%s"
+
+	defaultRTSPPort        = 554
+	normalizedScreenLength = 1000
+	minRegionCoordinates   = 3
+	maxPTZSpeed            = 100
+	ptzStopTimeout         = timeout * time.Second
+	g711BytesPerSecond     = 8000
+	g726BytesPerSecond     = 2000
+	audioFramesPerSecond   = 50
+	wavFormatPCM           = 1
+	mulawSampleRate        = 8000
+	mulawBias              = 0x84
+	mulawClip              = 32635
+	wavHeaderLength        = 12
+	wavChunkHeader         = 8
+	wavFmtMinLength        = 16
+	wavUnknownLength       = math.MaxUint32
+	defaultSearchPageSize  = 50
+	defaultRetryBackoff    = 500 * time.Millisecond
+	recordTypeDescriptor   = "//recordType.meta.std-cgi.com/"
+	searchTimeFormat       = "2006-01-02T15:04:05Z"
+	searchTimeLocalFormat  = "2006-01-02T15:04:05"
+	searchStatusMore       = "MORE"
+	playbackTimeFormat     = "20060102T150405Z"
+	pictureTrack           = 3
+	scheduleBlockType      = "www.std-cgi.com/racm/schedule/ver10"
+	timelineMergeTolerance = 2 * time.Second
+	defaultDSTBias         = time.Hour
+	defaultDSTTransition   = 2 * time.Hour
+	defaultOnlineTimeout   = 2 * time.Minute
+	defaultOnlineInterval  = 3 * time.Second
+	defaultShutdownGrace   = 30 * time.Second
+	defaultUpgradeInterval = 2 * time.Second
+	firmwareHeaderSize     = 64 * 1024
 )
 
+var eventSchedules = map[models.EventType]string{
+	models.EventTypeVMD:                  "motionDetections/VMD_video%d",
+	models.EventTypeTamper:               "tamperDetections/Tamper_video%d",
+	models.EventTypeVideoLoss:            "videoLosses/VideoLoss_video%d",
+	models.EventTypeLineDetection:        "lineDetections/lineDetection_video%d",
+	models.EventTypeFieldDetection:       "fieldDetections/fieldDetection_video%d",
+	models.EventTypeRegionEntrance:       "regionEntrance/regionEntrance_video%d",
+	models.EventTypeRegionExiting:        "regionExiting/regionExiting_video%d",
+	models.EventTypeSceneChangeDetection: "sceneChangeDetections/sceneChangeDetection_video%d",
+	models.EventTypeFace:                 "faceDetections/faceDetection_video%d",
+	models.EventTypeIO:                   "inputs/IO-%d",
+}
+
+func getInputChannelPath(channel int) string {
+	return fmt.Sprintf(inputChannelPath, channel)
+}
+
 func getMotionDetectionPath(channel int) string {
 	return fmt.Sprintf(motionDetectionPath, channel)
 }
 
-func getMotionSchedulePath(channel int) string {
-	return fmt.Sprintf(motionSchedule, channel)
+func getTamperDetectionPath(channel int) string {
+	return fmt.Sprintf(tamperDetectionPath, channel)
+}
+
+func getVideoLossPath(channel int) string {
+	return fmt.Sprintf(videoLossPath, channel)
+}
+
+func getOverlaysPath(channel int) string {
+	return fmt.Sprintf(overlaysPath, channel)
+}
+
+func getPrivacyMaskPath(channel int) string {
+	return fmt.Sprintf(privacyMaskPath, channel)
+}
+
+func getPrivacyMaskCapabilitiesPath(channel int) string {
+	return fmt.Sprintf(privacyMaskCapsPath, channel)
+}
+
+func getEventSchedulePath(eventType models.EventType, channel int) (string, error) {
+	schedule, ok := eventSchedules[eventType]
+	if !ok {
+		return "", NewAnnkeValidationError("eventType", fmt.Sprintf("no schedule known for event type %q", eventType))
+	}
+	return eventSchedule + fmt.Sprintf(schedule, channel), nil
+}
+
+func getEventTriggerPath(eventType models.EventType, channel int) string {
+	return fmt.Sprintf(eventTrigger, eventType, channel)
+}
+
+func getRegionEntrancePath(channel int) string {
+	return fmt.Sprintf(regionEntrancePath, channel)
+}
+
+func getRegionExitingPath(channel int) string {
+	return fmt.Sprintf(regionExitingPath, channel)
+}
+
+func getIOInputPath(id int) string {
+	return fmt.Sprintf(ioInputPath, id)
+}
+
+func getIOOutputPath(id int) string {
+	return fmt.Sprintf(ioOutputPath, id)
+}
+
+func getIOOutputTriggerPath(id int) string {
+	return fmt.Sprintf(ioOutputTriggerPath, id)
+}
+
+func getStreamingChannelPath(channel, stream int) string {
+	return fmt.Sprintf(streamingChannel, StreamID(channel, stream))
+}
+
+func getSnapshotPath(channel, stream int) string {
+	return fmt.Sprintf(snapshotPath, StreamID(channel, stream))
+}
+
+func getImageChannelPath(channel int) string {
+	return fmt.Sprintf(imageChannelPath, channel)
+}
+
+func getImageSettingPath(channel int, setting string) string {
+	return fmt.Sprintf(imageSettingPath, channel, setting)
+}
+
+func getPTZPath(channel int, action string) string {
+	return fmt.Sprintf(ptzPath, channel, action)
+}
+
+func getPTZItemPath(channel int, collection string, id int) string {
+	return fmt.Sprintf(ptzItemPath, channel, collection, id)
+}
+
+func getAudioChannelPath(channel int) string {
+	return fmt.Sprintf(audioChannelPath, channel)
+}
+
+func getTwoWayAudioChannelPath(channel int) string {
+	return fmt.Sprintf(twoWayAudioChannel, channel)
+}
+
+func getTwoWayAudioActionPath(channel int, action string) string {
+	return fmt.Sprintf(twoWayAudioAction, channel, action)
+}
+
+func getRecordTrackPath(track int) string {
+	return fmt.Sprintf(recordTrackPath, track)
+}
+
+func getDailyDistributionPath(track int) string {
+	return fmt.Sprintf(dailyDistribution, track)
+}
+
+func getHDDActionPath(hdd string, action string) string {
+	return fmt.Sprintf(hddActionPath, hdd, action)
+}
+
+func getQuotaPath(id int) string {
+	return fmt.Sprintf(quotaPath, id)
+}
+
+func getNTPServerPath(id int) string {
+	return fmt.Sprintf(ntpServerPath, id)
+}
+
+func getNetworkInterfacePath(id int) string {
+	return fmt.Sprintf(networkInterface, id)
 }
 
-func getEventTriggerPath(channel int) string {
-	return fmt.Sprintf(eventTrigger, channel)
+func getFactoryResetPath(mode string) string {
+	return fmt.Sprintf(factoryResetPath, mode)
 }
@@ -0,0 +1,84 @@
+package annkesdk
+
+import "github.com/csrar/annkeSDK/models"
+
+func (c Connector) GetTamperDetection(channel int) (models.TamperDetection, error) {
+	tamperDetection := models.TamperDetection{}
+	err := c.makeGetRequest(getTamperDetectionPath(channel), &tamperDetection)
+	return tamperDetection, err
+}
+
+func (c Connector) GetTamperSchedule(channel int) (models.MotionSchedule, error) {
+	return c.GetEventSchedule(models.EventTypeTamper, channel)
+}
+
+func (c Connector) GetTamperTrigger(channel int) (models.EventTrigger, error) {
+	return c.GetEventTriggerFor(models.EventTypeTamper, channel)
+}
+
+func (c Connector) UpdateTamperDetection(channel int, tamperDetection models.TamperDetection) error {
+	return c.makeUpdateRequest(getTamperDetectionPath(channel), tamperDetection)
+}
+
+func (c Connector) UpdateTamperSchedule(channel int, schedule models.MotionSchedule) error {
+	return c.UpdateEventSchedule(models.EventTypeTamper, channel, schedule)
+}
+
+func (c Connector) UpdateTamperTrigger(channel int, trigger models.EventTrigger) error {
+	return c.UpdateEventTriggerFor(models.EventTypeTamper, channel, trigger)
+}
+
+func (c Connector) GetVideoLoss(channel int) (models.VideoLoss, error) {
+	videoLoss := models.VideoLoss{}
+	err := c.makeGetRequest(getVideoLossPath(channel), &videoLoss)
+	return videoLoss, err
+}
+
+func (c Connector) GetVideoLossSchedule(channel int) (models.MotionSchedule, error) {
+	return c.GetEventSchedule(models.EventTypeVideoLoss, channel)
+}
+
+func (c Connector) GetVideoLossTrigger(channel int) (models.EventTrigger, error) {
+	return c.GetEventTriggerFor(models.EventTypeVideoLoss, channel)
+}
+
+func (c Connector) UpdateVideoLoss(channel int, videoLoss models.VideoLoss) error {
+	return c.makeUpdateRequest(getVideoLossPath(channel), videoLoss)
+}
+
+func (c Connector) UpdateVideoLossSchedule(channel int, schedule models.MotionSchedule) error {
+	return c.UpdateEventSchedule(models.EventTypeVideoLoss, channel, schedule)
+}
+
+func (c Connector) UpdateVideoLossTrigger(channel int, trigger models.EventTrigger) error {
+	return c.UpdateEventTriggerFor(models.EventTypeVideoLoss, channel, trigger)
+}
+
+func (c Connector) GetChannelStatus() ([]models.ChannelStatus, error) {
+	channels, err := c.GetChannels()
+	if err != nil {
+		return nil, err
+	}
+	statusList := models.VideoInputChannelStatusList{}
+	if err := c.makeGetRequest(channelsStatusPath, &statusList); err != nil {
+		return nil, err
+	}
+
+	online := make(map[string]bool, len(statusList.VideoInputChannelStatus))
+	for _, status := range statusList.VideoInputChannelStatus {
+		online[status.ID] = status.Online
+	}
+	statuses := make([]models.ChannelStatus, 0, len(channels.VideoInputChannel))
+	for _, channel := range channels.VideoInputChannel {
+		status := models.ChannelStatus{
+			ID:      channel.ID,
+			Name:    channel.Name,
+			Enabled: channel.VideoInputEnabled == "true",
+		}
+		if channelOnline, ok := online[channel.ID]; ok {
+			status.Online = &channelOnline
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
@@ -0,0 +1,83 @@
+package annkesdk
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_GetChannelStatus(t *testing.T) {
+	channels := "<VideoInputChannelList><VideoInputChannel><id>1</id><videoInputEnabled>true</videoInputEnabled><name>Door</name></VideoInputChannel><VideoInputChannel><id>2</id><videoInputEnabled>false</videoInputEnabled><name>Yard</name></VideoInputChannel><VideoInputChannel><id>3</id><videoInputEnabled>true</videoInputEnabled><name>Dock</name></VideoInputChannel></VideoInputChannelList>"
+	status := "<VideoInputChannelStatusList><VideoInputChannelStatus><id>1</id><online>true</online></VideoInputChannelStatus><VideoInputChannelStatus><id>2</id><online>false</online></VideoInputChannelStatus></VideoInputChannelStatusList>"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case inputChannelsPath:
+			fmt.Fprintln(w, channels)
+		case channelsStatusPath:
+			fmt.Fprintln(w, status)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	result, err := c.GetChannelStatus()
+	assert.Nil(t, err)
+	online, offline := true, false
+	assert.Equal(t, []models.ChannelStatus{
+		{ID: "1", Name: "Door", Enabled: true, Online: &online},
+		{ID: "2", Name: "Yard", Enabled: false, Online: &offline},
+		{ID: "3", Name: "Dock", Enabled: true},
+	}, result)
+}
+
+func TestConnector_GetChannelStatusError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, "mock-error")
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	result, err := c.GetChannelStatus()
+	assert.Nil(t, result)
+	assert.ErrorContains(t, err, "status: 403 payload: mock-error")
+}
+
+func TestConnector_TamperDetection(t *testing.T) {
+	tamper := "<TamperDetection><enabled>true</enabled><normalizedScreenSize><normalizedScreenWidth>704</normalizedScreenWidth><normalizedScreenHeight>576</normalizedScreenHeight></normalizedScreenSize><TamperDetectionRegionList><TamperDetectionRegion><id>1</id><enabled>true</enabled><sensitivityLevel>60</sensitivityLevel><RegionCoordinatesList><RegionCoordinates><positionX>0</positionX><positionY>0</positionY></RegionCoordinates><RegionCoordinates><positionX>704</positionX><positionY>576</positionY></RegionCoordinates></RegionCoordinatesList></TamperDetectionRegion></TamperDetectionRegionList></TamperDetection>"
+	var updated string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path != "/ISAPI/System/Video/inputs/channels/3/tamperDetection":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "PUT":
+			body, _ := io.ReadAll(r.Body)
+			updated = string(body)
+		default:
+			fmt.Fprintln(w, tamper)
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	result, err := c.GetTamperDetection(3)
+	assert.Nil(t, err)
+	assert.True(t, result.Enabled)
+	assert.Equal(t, 576, result.NormalizedScreenSize.NormalizedScreenHeight)
+	regions := result.TamperDetectionRegionList.TamperDetectionRegion
+	assert.Len(t, regions, 1)
+	assert.Equal(t, 60, regions[0].SensitivityLevel)
+	assert.Equal(t, models.RegionCoordinates{PositionX: 704, PositionY: 576}, regions[0].RegionCoordinatesList.RegionCoordinates[1])
+
+	result.Enabled = false
+	assert.Nil(t, c.UpdateTamperDetection(3, result))
+	assert.Equal(t, strings.Replace(tamper, "<TamperDetection><enabled>true", "<TamperDetection><enabled>false", 1), updated)
+}
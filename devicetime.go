@@ -0,0 +1,184 @@
+package annkesdk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+var (
+	timeZonePattern = regexp.MustCompile(`^([A-Za-z]+)([+-]?\d{1,2}(?::\d{2}){0,2})(?:([A-Za-z]+)([+-]?\d{1,2}(?::\d{2}){0,2})?(?:,([^,]+),([^,]+))?)?$`)
+	dstRulePattern  = regexp.MustCompile(`^M(\d{1,2})\.(\d)\.(\d)(?:/(\d{1,2}(?::\d{2}){0,2}))?$`)
+)
+
+func (c Connector) GetTime(ctx context.Context) (models.Time, error) {
+	deviceTime := models.Time{}
+	err := c.makeRequest(ctx, "GET", timePath, nil, &deviceTime)
+	return deviceTime, err
+}
+
+func (c Connector) UpdateTime(ctx context.Context, deviceTime models.Time) error {
+	return c.makeRequest(ctx, "PUT", timePath, deviceTime, nil)
+}
+
+func (c Connector) GetNTPServers(ctx context.Context) (models.NTPServerList, error) {
+	servers := models.NTPServerList{}
+	err := c.makeRequest(ctx, "GET", ntpServersPath, nil, &servers)
+	return servers, err
+}
+
+func (c Connector) GetNTPServer(ctx context.Context, id int) (models.NTPServer, error) {
+	server := models.NTPServer{}
+	err := c.makeRequest(ctx, "GET", getNTPServerPath(id), nil, &server)
+	return server, err
+}
+
+func (c Connector) UpdateNTPServers(ctx context.Context, servers models.NTPServerList) error {
+	return c.makeRequest(ctx, "PUT", ntpServersPath, servers, nil)
+}
+
+func (c Connector) UpdateNTPServer(ctx context.Context, id int, server models.NTPServer) error {
+	return c.makeRequest(ctx, "PUT", getNTPServerPath(id), server, nil)
+}
+
+func (c Connector) CheckClockSkew(ctx context.Context) (time.Duration, error) {
+	before := time.Now()
+	deviceTime, err := c.GetTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	after := time.Now()
+
+	localTime, err := parseDeviceTime(deviceTime)
+	if err != nil {
+		return 0, err
+	}
+	midpoint := before.Add(after.Sub(before) / 2)
+	return localTime.Sub(midpoint), nil
+}
+
+func parseDeviceTime(deviceTime models.Time) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, deviceTime.LocalTime); err == nil {
+		return parsed, nil
+	}
+	wallClock, err := time.ParseInLocation(searchTimeLocalFormat, deviceTime.LocalTime, time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Error parsing device time %s %w", deviceTime.LocalTime, err)
+	}
+	timeZone, err := ParseTimeZone(deviceTime.TimeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	offset := timeZone.Offset
+	if timeZone.DST != nil && dstActive(*timeZone.DST, wallClock) {
+		offset += timeZone.DST.Bias
+	}
+	return wallClock.Add(-offset), nil
+}
+
+func dstActive(rule models.DSTRule, wallClock time.Time) bool {
+	start, startOK := dstTransition(rule.Start, wallClock.Year())
+	end, endOK := dstTransition(rule.End, wallClock.Year())
+	if !startOK || !endOK {
+		return false
+	}
+	if start.Before(end) {
+		return !wallClock.Before(start) && wallClock.Before(end)
+	}
+	return !wallClock.Before(start) || wallClock.Before(end)
+}
+
+func dstTransition(value string, year int) (time.Time, bool) {
+	match := dstRulePattern.FindStringSubmatch(value)
+	if match == nil {
+		return time.Time{}, false
+	}
+	month, _ := strconv.Atoi(match[1])
+	week, _ := strconv.Atoi(match[2])
+	weekday, _ := strconv.Atoi(match[3])
+	if month < 1 || month > 12 || week < 1 || week > 5 || weekday > 6 {
+		return time.Time{}, false
+	}
+	at := defaultDSTTransition
+	if match[4] != "" {
+		var err error
+		if at, err = parseTimeZoneOffset(match[4]); err != nil {
+			return time.Time{}, false
+		}
+	}
+
+	first := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	day := first.AddDate(0, 0, (weekday-int(first.Weekday())+7)%7+(week-1)*7)
+	for day.Month() != first.Month() {
+		day = day.AddDate(0, 0, -7)
+	}
+	return day.Add(at), true
+}
+
+func ParseTimeZone(value string) (models.TimeZone, error) {
+	match := timeZonePattern.FindStringSubmatch(value)
+	if match == nil {
+		return models.TimeZone{}, NewAnnkeValidationError("timeZone", "unrecognized time zone "+value)
+	}
+	offset, err := parseTimeZoneOffset(match[2])
+	if err != nil {
+		return models.TimeZone{}, err
+	}
+	timeZone := models.TimeZone{Name: match[1], Offset: -offset}
+	if match[3] == "" {
+		return timeZone, nil
+	}
+
+	bias := defaultDSTBias
+	if match[4] != "" {
+		if bias, err = parseTimeZoneOffset(match[4]); err != nil {
+			return models.TimeZone{}, err
+		}
+	}
+	timeZone.DST = &models.DSTRule{Name: match[3], Bias: bias, Start: match[5], End: match[6]}
+	return timeZone, nil
+}
+
+func FormatTimeZone(timeZone models.TimeZone) string {
+	value := timeZone.Name + formatTimeZoneOffset(-timeZone.Offset)
+	if dst := timeZone.DST; dst != nil {
+		value += dst.Name + formatTimeZoneOffset(dst.Bias)
+		if dst.Start != "" && dst.End != "" {
+			value += "," + dst.Start + "," + dst.End
+		}
+	}
+	return value
+}
+
+func parseTimeZoneOffset(value string) (time.Duration, error) {
+	sign := time.Duration(1)
+	if strings.HasPrefix(value, "-") {
+		sign = -1
+	}
+	parts := strings.Split(strings.TrimLeft(value, "+-"), ":")
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	offset := time.Duration(0)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, NewAnnkeValidationError("timeZone", "invalid offset "+value)
+		}
+		offset += time.Duration(number) * units[i]
+	}
+	return sign * offset, nil
+}
+
+func formatTimeZoneOffset(offset time.Duration) string {
+	sign := ""
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	seconds := int(offset / time.Second)
+	return fmt.Sprintf("%s%d:%02d:%02d", sign, seconds/3600, seconds/60%60, seconds%60)
+}
@@ -0,0 +1,120 @@
+package annkesdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeZone(t *testing.T) {
+	cases := []struct {
+		name          string
+		value         string
+		expected      models.TimeZone
+		expectedError string
+	}{
+		{
+			name:     "without DST",
+			value:    "CST-8:00:00",
+			expected: models.TimeZone{Name: "CST", Offset: 8 * time.Hour},
+		},
+		{
+			name:  "with DST rule",
+			value: "EST5:00:00DST01:00:00,M3.2.0/02:00:00,M11.1.0/02:00:00",
+			expected: models.TimeZone{
+				Name:   "EST",
+				Offset: -5 * time.Hour,
+				DST:    &models.DSTRule{Name: "DST", Bias: time.Hour, Start: "M3.2.0/02:00:00", End: "M11.1.0/02:00:00"},
+			},
+		},
+		{
+			name:     "half hour offset with default bias",
+			value:    "IST-5:30:00DST",
+			expected: models.TimeZone{Name: "IST", Offset: 5*time.Hour + 30*time.Minute, DST: &models.DSTRule{Name: "DST", Bias: time.Hour}},
+		},
+		{
+			name:          "invalid zone",
+			value:         "mock zone",
+			expectedError: "invalid parameter timeZone: unrecognized time zone mock zone",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			timeZone, err := ParseTimeZone(tc.value)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, timeZone)
+		})
+	}
+}
+
+func TestFormatTimeZone(t *testing.T) {
+	timeZone := models.TimeZone{
+		Name:   "EST",
+		Offset: -5 * time.Hour,
+		DST:    &models.DSTRule{Name: "DST", Bias: time.Hour, Start: "M3.2.0/02:00:00", End: "M11.1.0/02:00:00"},
+	}
+	assert.Equal(t, "EST5:00:00DST1:00:00,M3.2.0/02:00:00,M11.1.0/02:00:00", FormatTimeZone(timeZone))
+	assert.Equal(t, "CST-8:00:00", FormatTimeZone(models.TimeZone{Name: "CST", Offset: 8 * time.Hour}))
+}
+
+func TestConnector_CheckClockSkew(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deviceTime := time.Now().Add(-90 * time.Second).In(time.FixedZone("", 8*3600))
+		fmt.Fprintf(w, "<Time><timeMode>manual</timeMode><localTime>%s</localTime><timeZone>CST-8:00:00</timeZone></Time>", deviceTime.Format(time.RFC3339))
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	skew, err := c.CheckClockSkew(context.Background())
+	assert.Nil(t, err)
+	assert.InDelta(t, (-90 * time.Second).Seconds(), skew.Seconds(), 2)
+}
+
+func TestConnector_CheckClockSkewWithoutOffset(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deviceTime := time.Now().Add(30 * time.Second).In(time.FixedZone("", 8*3600))
+		fmt.Fprintf(w, "<Time><timeMode>manual</timeMode><localTime>%s</localTime><timeZone>CST-8:00:00</timeZone></Time>", deviceTime.Format("2006-01-02T15:04:05"))
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	skew, err := c.CheckClockSkew(context.Background())
+	assert.Nil(t, err)
+	assert.InDelta(t, (30 * time.Second).Seconds(), skew.Seconds(), 2)
+}
+
+func TestParseDeviceTime(t *testing.T) {
+	zone := "EST5:00:00DST01:00:00,M3.2.0/02:00:00,M11.1.0/02:00:00"
+	cases := []struct {
+		name      string
+		localTime string
+		timeZone  string
+		expected  time.Time
+	}{
+		{name: "with offset", localTime: "2024-01-15T12:00:00+08:00", expected: time.Date(2024, 1, 15, 4, 0, 0, 0, time.UTC)},
+		{name: "standard time", localTime: "2024-01-15T12:00:00", timeZone: zone, expected: time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)},
+		{name: "daylight saving time", localTime: "2024-07-01T12:00:00", timeZone: zone, expected: time.Date(2024, 7, 1, 16, 0, 0, 0, time.UTC)},
+		{name: "after DST ends", localTime: "2024-11-03T02:00:00", timeZone: zone, expected: time.Date(2024, 11, 3, 7, 0, 0, 0, time.UTC)},
+		{name: "southern hemisphere", localTime: "2024-01-15T12:00:00", timeZone: "AEST-10:00:00AEDT01:00:00,M10.1.0/02:00:00,M4.1.0/03:00:00", expected: time.Date(2024, 1, 15, 1, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsed, err := parseDeviceTime(models.Time{LocalTime: tc.localTime, TimeZone: tc.timeZone})
+			assert.Nil(t, err)
+			assert.True(t, tc.expected.Equal(parsed), "expected %s got %s", tc.expected, parsed)
+		})
+	}
+
+	_, err := parseDeviceTime(models.Time{LocalTime: "2024-01-15T12:00:00", TimeZone: "mock zone"})
+	assert.EqualError(t, err, "invalid parameter timeZone: unrecognized time zone mock zone")
+}
@@ -0,0 +1,175 @@
+package annkesdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+type DownloadOptions struct {
+	Offset       int64
+	Retries      int
+	RetryBackoff time.Duration
+	MaxSegment   time.Duration
+	Progress     func(progress DownloadProgress)
+}
+
+type DownloadProgress struct {
+	Segment        int
+	Segments       int
+	SegmentWritten int64
+	SegmentSize    int64
+	Written        int64
+}
+
+type progressWriter struct {
+	w        io.Writer
+	progress DownloadProgress
+	report   func(progress DownloadProgress)
+}
+
+func (c Connector) DownloadRecording(ctx context.Context, playbackURI string, w io.Writer, opts DownloadOptions) (int64, error) {
+	segments, err := splitPlaybackURI(playbackURI, opts.MaxSegment)
+	if err != nil {
+		return 0, err
+	}
+	if opts.Offset > 0 && len(segments) > 1 {
+		return 0, NewAnnkeValidationError("Offset", "resuming is only supported for single segment downloads")
+	}
+
+	writer := &progressWriter{w: w, report: opts.Progress}
+	writer.progress.Segments = len(segments)
+	writer.progress.Written = opts.Offset
+	for i, segment := range segments {
+		writer.progress.Segment = i + 1
+		writer.progress.SegmentWritten = 0
+		writer.progress.SegmentSize = -1
+		if i == 0 {
+			writer.progress.SegmentWritten = opts.Offset
+		}
+		if err := c.downloadSegment(ctx, segment, writer, opts); err != nil {
+			return writer.progress.Written, err
+		}
+	}
+	return writer.progress.Written, nil
+}
+
+func (c Connector) downloadSegment(ctx context.Context, playbackURI string, w *progressWriter, opts DownloadOptions) error {
+	backoff := opts.RetryBackoff
+	if backoff <= 0 {
+		backoff = defaultRetryBackoff
+	}
+	for attempt := 0; ; attempt++ {
+		err := c.downloadOnce(ctx, playbackURI, w)
+		if err == nil {
+			return nil
+		}
+		var restErr AnnkeRestError
+		if ctx.Err() != nil || attempt >= opts.Retries || errors.As(err, &restErr) {
+			return err
+		}
+		timer := time.NewTimer(backoff << attempt)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+func (c Connector) downloadOnce(ctx context.Context, playbackURI string, w *progressWriter) error {
+	body, err := xml.Marshal(models.DownloadRequest{Version: "1.0", PlaybackURI: playbackURI})
+	if err != nil {
+		return err
+	}
+	offset := w.progress.SegmentWritten
+	header := http.Header{}
+	header.Set("Content-Type", "application/xml")
+	if offset > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := c.makeStreamRequest(ctx, "GET", downloadPath, header, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.ContentLength >= 0 {
+		w.progress.SegmentSize = resp.ContentLength
+		if resp.StatusCode == http.StatusPartialContent {
+			w.progress.SegmentSize += offset
+		}
+	}
+	if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+		if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+			return fmt.Errorf("Error skipping %d downloaded bytes %w", offset, err)
+		}
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+func (w *progressWriter) Write(b []byte) (int, error) {
+	n, err := w.w.Write(b)
+	w.progress.SegmentWritten += int64(n)
+	w.progress.Written += int64(n)
+	if w.report != nil && n > 0 {
+		w.report(w.progress)
+	}
+	return n, err
+}
+
+func splitPlaybackURI(playbackURI string, maxSegment time.Duration) ([]string, error) {
+	if maxSegment <= 0 {
+		return []string{playbackURI}, nil
+	}
+	parsed, err := url.Parse(playbackURI)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing playback URI %w", err)
+	}
+	query := parsed.Query()
+	start, err := parsePlaybackTime(query.Get("starttime"))
+	if err != nil {
+		return nil, err
+	}
+	end, err := parsePlaybackTime(query.Get("endtime"))
+	if err != nil {
+		return nil, err
+	}
+	if !end.After(start) {
+		return nil, NewAnnkeValidationError("playbackURI", "endtime must be after starttime")
+	}
+
+	query.Del("size")
+	query.Del("name")
+	segments := []string{}
+	for segmentStart := start; segmentStart.Before(end); segmentStart = segmentStart.Add(maxSegment) {
+		segmentEnd := segmentStart.Add(maxSegment)
+		if segmentEnd.After(end) {
+			segmentEnd = end
+		}
+		query.Set("starttime", segmentStart.UTC().Format(playbackTimeFormat))
+		query.Set("endtime", segmentEnd.UTC().Format(playbackTimeFormat))
+		parsed.RawQuery = query.Encode()
+		segments = append(segments, parsed.String())
+	}
+	return segments, nil
+}
+
+func parsePlaybackTime(value string) (time.Time, error) {
+	parsed, err := time.Parse(playbackTimeFormat, value)
+	if err == nil {
+		return parsed, nil
+	}
+	return parseSearchTime(value)
+}
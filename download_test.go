@@ -0,0 +1,66 @@
+package annkesdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_DownloadRecordingResume(t *testing.T) {
+	content := "mock-recording-content"
+	var ranges []string
+	var requested []time.Time
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, time.Now())
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), "<playbackURI>rtsp://mock/Streaming/tracks/101/?starttime=20240301T000000Z&amp;endtime=20240301T001000Z</playbackURI>")
+		ranges = append(ranges, r.Header.Get("Range"))
+		if r.Header.Get("Range") == "" {
+			w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+			fmt.Fprint(w, content[:10])
+			w.(http.Flusher).Flush()
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusPartialContent)
+		fmt.Fprint(w, content[10:])
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	out := &bytes.Buffer{}
+	var progress []DownloadProgress
+	written, err := c.DownloadRecording(context.Background(), "rtsp://mock/Streaming/tracks/101/?starttime=20240301T000000Z&endtime=20240301T001000Z", out, DownloadOptions{
+		Retries:      1,
+		RetryBackoff: 30 * time.Millisecond,
+		Progress:     func(p DownloadProgress) { progress = append(progress, p) },
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), written)
+	assert.Equal(t, content, out.String())
+	assert.Equal(t, []string{"", "bytes=10-"}, ranges)
+	assert.GreaterOrEqual(t, requested[1].Sub(requested[0]), 30*time.Millisecond)
+	assert.Equal(t, int64(len(content)), progress[len(progress)-1].SegmentSize)
+}
+
+func TestSplitPlaybackURI(t *testing.T) {
+	segments, err := splitPlaybackURI("rtsp://mock/Streaming/tracks/101/?starttime=20240301T000000Z&endtime=20240301T002500Z&name=00010000123&size=1000", 10*time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"rtsp://mock/Streaming/tracks/101/?endtime=20240301T001000Z&starttime=20240301T000000Z",
+		"rtsp://mock/Streaming/tracks/101/?endtime=20240301T002000Z&starttime=20240301T001000Z",
+		"rtsp://mock/Streaming/tracks/101/?endtime=20240301T002500Z&starttime=20240301T002000Z",
+	}, segments)
+
+	_, err = splitPlaybackURI("rtsp://mock/Streaming/tracks/101/?starttime=mock", time.Minute)
+	assert.True(t, strings.HasPrefix(err.Error(), "Error parsing search time mock"))
+}
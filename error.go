@@ -1,6 +1,11 @@
 package annkesdk
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
 
 type AnnkeRestError struct {
 	Status  int
@@ -33,3 +38,27 @@ func NewAnnkeInitError(parameter string) AnnkeInitError {
 func (ae AnnkeInitError) Error() string {
 	return fmt.Sprintf("error initializing Annke connection, missing parameter: %s", ae.Parameter)
 }
+
+type AnnkeValidationError struct {
+	Parameter string
+	Message   string
+}
+
+func NewAnnkeValidationError(parameter string, message string) AnnkeValidationError {
+	return AnnkeValidationError{
+		Parameter: parameter,
+		Message:   message,
+	}
+}
+
+func (ae AnnkeValidationError) Error() string {
+	return fmt.Sprintf("invalid parameter %s: %s", ae.Parameter, ae.Message)
+}
+
+func isNotSupported(err error) bool {
+	var restErr AnnkeRestError
+	if !errors.As(err, &restErr) {
+		return false
+	}
+	return restErr.Status == http.StatusNotFound || strings.Contains(restErr.Message, "notSupport")
+}
@@ -2,6 +2,7 @@ package annkesdk
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -23,15 +24,33 @@ func (c Connector) GetMotionDetection(channel int) (models.MotionDetection, erro
 }
 
 func (c Connector) GetMotionSchedule(channel int) (models.MotionSchedule, error) {
-	motionSchedule := models.MotionSchedule{}
-	err := c.makeGetRequest(getMotionSchedulePath(channel), &motionSchedule)
-	return motionSchedule, err
+	return c.GetEventSchedule(models.EventTypeVMD, channel)
 }
 
 func (c Connector) GetEventTrigger(channel int) (models.EventTrigger, error) {
-	motionTrigger := models.EventTrigger{}
-	err := c.makeGetRequest(getEventTriggerPath(channel), &motionTrigger)
-	return motionTrigger, err
+	return c.GetEventTriggerFor(models.EventTypeVMD, channel)
+}
+
+func (c Connector) GetEventSchedule(eventType models.EventType, channel int) (models.MotionSchedule, error) {
+	schedule := models.MotionSchedule{}
+	path, err := getEventSchedulePath(eventType, channel)
+	if err != nil {
+		return schedule, err
+	}
+	err = c.makeGetRequest(path, &schedule)
+	return schedule, err
+}
+
+func (c Connector) GetEventTriggerFor(eventType models.EventType, channel int) (models.EventTrigger, error) {
+	trigger := models.EventTrigger{}
+	err := c.makeGetRequest(getEventTriggerPath(eventType, channel), &trigger)
+	return trigger, err
+}
+
+func (c Connector) ListEventTriggers() (models.EventTriggerList, error) {
+	triggers := models.EventTriggerList{}
+	err := c.makeGetRequest(eventTriggersPath, &triggers)
+	return triggers, err
 }
 
 func (c Connector) UpdateMotionDetection(channel int, motion models.MotionDetection) error {
@@ -39,61 +58,100 @@ func (c Connector) UpdateMotionDetection(channel int, motion models.MotionDetect
 }
 
 func (c Connector) UpdateMotionSchedule(channel int, motionSchelude models.MotionSchedule) error {
-	return c.makeUpdateRequest(getMotionSchedulePath(channel), motionSchelude)
+	return c.UpdateEventSchedule(models.EventTypeVMD, channel, motionSchelude)
 }
 
 func (c Connector) UpdateEventTrigger(channel int, eventTrigger models.EventTrigger) error {
-	return c.makeUpdateRequest(getEventTriggerPath(channel), eventTrigger)
+	return c.UpdateEventTriggerFor(models.EventTypeVMD, channel, eventTrigger)
 }
 
-func (c Connector) makeUpdateRequest(path string, body interface{}) error {
-	url := fmt.Sprintf("%s://%s%s", c.getProtocol(), c.Host, path)
-	data, err := xml.Marshal(body)
+func (c Connector) UpdateEventSchedule(eventType models.EventType, channel int, schedule models.MotionSchedule) error {
+	path, err := getEventSchedulePath(eventType, channel)
 	if err != nil {
 		return err
 	}
+	return c.makeUpdateRequest(path, schedule)
+}
+
+func (c Connector) UpdateEventTriggerFor(eventType models.EventType, channel int, eventTrigger models.EventTrigger) error {
+	return c.makeUpdateRequest(getEventTriggerPath(eventType, channel), eventTrigger)
+}
+
+func (c Connector) makeUpdateRequest(path string, body interface{}) error {
+	return c.makeRequest(context.Background(), "PUT", path, body, nil)
+}
+
+func (c Connector) makeGetRequest(path string, data interface{}) error {
+	return c.makeRequest(context.Background(), "GET", path, nil, data)
+}
+
+func (c Connector) makeRequest(ctx context.Context, method, path string, body interface{}, data interface{}) error {
+	url := fmt.Sprintf("%s://%s%s", c.getProtocol(), c.Host, path)
+	var reader io.Reader
+	if body != nil {
+		payload, err := xml.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
 
-	reader := bytes.NewReader(data)
-	req, err := http.NewRequest("PUT", url, reader)
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
 	if err != nil {
 		return err
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=UTF-8")
-	response, err := c.client.Do(req)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=UTF-8")
+	}
+	resp, err := c.client.Do(req)
 	if err != nil {
 		return err
 	}
-	responseBody, err := io.ReadAll(response.Body)
+	defer resp.Body.Close()
+	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return err
 	}
-	if response.StatusCode != http.StatusOK {
-		return NewAnnkeError(response.StatusCode, string(responseBody), req.RequestURI)
+	if resp.StatusCode != http.StatusOK {
+		return NewAnnkeError(resp.StatusCode, string(responseBody), path)
+	}
+	if data == nil {
+		return nil
+	}
+	err = xml.Unmarshal(responseBody, data)
+
+	if err != nil {
+		return fmt.Errorf("Error unmarshaling %s response %w", path, err)
 	}
 	return nil
 }
 
-func (c Connector) makeGetRequest(path string, data interface{}) error {
+func (c Connector) makeStreamRequest(ctx context.Context, method, path string, header http.Header, body io.Reader) (*http.Response, error) {
 	url := fmt.Sprintf("%s://%s%s", c.getProtocol(), c.Host, path)
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return err
+	for key, values := range header {
+		req.Header[key] = values
 	}
-	body, err := io.ReadAll(resp.Body)
+	resp, err := c.streamingClient().Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return NewAnnkeError(resp.StatusCode, string(body), req.RequestURI)
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		defer resp.Body.Close()
+		responseBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, NewAnnkeError(resp.StatusCode, string(responseBody), path)
 	}
-	err = xml.Unmarshal(body, data)
+	return resp, nil
+}
 
-	if err != nil {
-		return fmt.Errorf("Error unmarshaling %s response %w", req.RequestURI, err)
-	}
-	return nil
+func (c Connector) streamingClient() *http.Client {
+	client := c.client
+	client.Timeout = 0
+	return &client
 }
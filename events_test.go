@@ -0,0 +1,70 @@
+package annkesdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEventSchedulePath(t *testing.T) {
+	cases := []struct {
+		name          string
+		eventType     models.EventType
+		expectedPath  string
+		expectedError string
+	}{
+		{
+			name:         "motion detection",
+			eventType:    models.EventTypeVMD,
+			expectedPath: "/ISAPI/Event/schedules/motionDetections/VMD_video2",
+		},
+		{
+			name:         "region entrance",
+			eventType:    models.EventTypeRegionEntrance,
+			expectedPath: "/ISAPI/Event/schedules/regionEntrance/regionEntrance_video2",
+		},
+		{
+			name:          "unknown event type",
+			eventType:     models.EventType("mock-event"),
+			expectedError: "invalid parameter eventType: no schedule known for event type \"mock-event\"",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := getEventSchedulePath(tc.eventType, 2)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tc.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestGetEventTriggerPath(t *testing.T) {
+	assert.Equal(t, "/ISAPI/Event/triggers/VMD-1", getEventTriggerPath(models.EventTypeVMD, 1))
+	assert.Equal(t, "/ISAPI/Event/triggers/linedetection-3", getEventTriggerPath(models.EventTypeLineDetection, 3))
+}
+
+func TestConnector_MakeRequestErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "mock-error")
+			return
+		}
+		fmt.Fprint(w, "mock-invalid-xml<")
+	}))
+	defer ts.Close()
+	c := Connector{Host: ts.URL[7:]}
+
+	err := c.UpdateMotionDetection(1, models.MotionDetection{})
+	assert.EqualError(t, err, "received unexpected response from: /ISAPI/System/Video/inputs/channels/1/motionDetection status: 403 payload: mock-error")
+	_, err = c.GetMotionDetection(1)
+	assert.ErrorContains(t, err, "Error unmarshaling /ISAPI/System/Video/inputs/channels/1/motionDetection response")
+}
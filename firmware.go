@@ -0,0 +1,166 @@
+package annkesdk
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+type FirmwareStage string
+
+const (
+	FirmwareStageUpload  FirmwareStage = "upload"
+	FirmwareStageUpgrade FirmwareStage = "upgrade"
+	FirmwareStageReboot  FirmwareStage = "reboot"
+)
+
+type FirmwareOptions struct {
+	Size            int64
+	ExpectedVersion string
+	PollInterval    time.Duration
+	Reboot          RebootOptions
+	HeaderModels    func(header []byte) ([]string, bool)
+	Progress        func(progress FirmwareProgress)
+}
+
+type FirmwareProgress struct {
+	Stage    FirmwareStage
+	Uploaded int64
+	Percent  int
+}
+
+type uploadReader struct {
+	r        io.Reader
+	progress FirmwareProgress
+	size     int64
+	report   func(progress FirmwareProgress)
+}
+
+func (c Connector) GetDeviceInfo() (models.DeviceInfo, error) {
+	deviceInfo := models.DeviceInfo{}
+	err := c.makeGetRequest(deviceInfoPath, &deviceInfo)
+	return deviceInfo, err
+}
+
+func (c Connector) GetUpgradeStatus(ctx context.Context) (models.UpgradeStatus, error) {
+	status := models.UpgradeStatus{}
+	err := c.makeRequest(ctx, "GET", upgradeStatusPath, nil, &status)
+	return status, err
+}
+
+// UpgradeFirmware refuses a model-mismatched image only when opts.HeaderModels
+// is set and reads the supported models from the header. Without a parser the
+// image is flashed unchecked. The new version is compared with
+// opts.ExpectedVersion when one is given.
+func (c Connector) UpgradeFirmware(ctx context.Context, firmware io.Reader, opts FirmwareOptions) (models.DeviceInfo, error) {
+	before, err := c.GetDeviceInfo()
+	if err != nil {
+		return before, err
+	}
+	reader := bufio.NewReaderSize(firmware, firmwareHeaderSize)
+	header, err := reader.Peek(firmwareHeaderSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return before, fmt.Errorf("Error reading firmware header %w", err)
+	}
+	if err := checkFirmwareModel(header, before.Model, opts.HeaderModels); err != nil {
+		return before, err
+	}
+
+	if err := c.uploadFirmware(ctx, reader, opts); err != nil {
+		return before, err
+	}
+	if err := c.waitUpgrade(ctx, opts); err != nil {
+		return before, err
+	}
+	opts.report(FirmwareProgress{Stage: FirmwareStageReboot})
+	if err := c.RebootAndWait(ctx, opts.Reboot); err != nil {
+		return before, err
+	}
+
+	after, err := c.GetDeviceInfo()
+	if err != nil {
+		return after, err
+	}
+	if opts.ExpectedVersion != "" && after.FirmwareVersion != opts.ExpectedVersion {
+		return after, fmt.Errorf("Error verifying firmware, expected version %s got %s", opts.ExpectedVersion, after.FirmwareVersion)
+	}
+	return after, nil
+}
+
+func (c Connector) uploadFirmware(ctx context.Context, firmware io.Reader, opts FirmwareOptions) error {
+	header := http.Header{}
+	header.Set("Content-Type", "application/octet-stream")
+	body := &uploadReader{r: firmware, size: opts.Size, report: opts.report}
+	body.progress.Stage = FirmwareStageUpload
+	resp, err := c.makeStreamRequest(ctx, "PUT", updateFirmwarePath, header, body)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c Connector) waitUpgrade(ctx context.Context, opts FirmwareOptions) error {
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultUpgradeInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status, err := c.GetUpgradeStatus(ctx)
+		if err != nil {
+			return err
+		}
+		opts.report(FirmwareProgress{Stage: FirmwareStageUpgrade, Percent: status.Percent})
+		if !status.Upgrading {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (opts FirmwareOptions) report(progress FirmwareProgress) {
+	if opts.Progress != nil {
+		opts.Progress(progress)
+	}
+}
+
+func (u *uploadReader) Read(b []byte) (int, error) {
+	n, err := u.r.Read(b)
+	if n > 0 {
+		u.progress.Uploaded += int64(n)
+		if u.size > 0 {
+			u.progress.Percent = int(u.progress.Uploaded * 100 / u.size)
+		}
+		u.report(u.progress)
+	}
+	return n, err
+}
+
+func checkFirmwareModel(header []byte, model string, headerModels func(header []byte) ([]string, bool)) error {
+	if headerModels == nil {
+		return nil
+	}
+	supported, ok := headerModels(header)
+	if !ok {
+		return nil
+	}
+	for _, pattern := range supported {
+		if matched, _ := path.Match(strings.ToUpper(pattern), strings.ToUpper(model)); matched {
+			return nil
+		}
+	}
+	return NewAnnkeValidationError("firmware", fmt.Sprintf("image is built for %s, device is %s", strings.Join(supported, ", "), model))
+}
@@ -0,0 +1,135 @@
+package annkesdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const mockDeviceInfo = `<DeviceInfo version="2.0"><deviceName>mock</deviceName><model>DS-MOCK</model><firmwareVersion>%s</firmwareVersion></DeviceInfo>`
+
+func mockUpgradeServer(t *testing.T, flashedVersion string, uploaded *int) *httptest.Server {
+	var mu sync.Mutex
+	version, polls, rebooted, offline := "V1.0", 0, false, false
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case deviceInfoPath:
+			fmt.Fprintf(w, mockDeviceInfo, version)
+		case updateFirmwarePath:
+			assert.Equal(t, "PUT", r.Method)
+			assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+			body, _ := io.ReadAll(r.Body)
+			*uploaded = len(body)
+		case upgradeStatusPath:
+			polls++
+			fmt.Fprintf(w, `<upgradeStatus><upgrading>%t</upgrading><percent>%d</percent></upgradeStatus>`, polls < 3, polls*50-50)
+		case rebootPath:
+			rebooted, version = true, flashedVersion
+		case loginPath:
+			if rebooted && !offline {
+				offline = true
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			fmt.Fprint(w, mockLoginCapabilities)
+		}
+	}))
+}
+
+func mockFirmwareOptions() FirmwareOptions {
+	return FirmwareOptions{
+		PollInterval: time.Millisecond,
+		Reboot: RebootOptions{
+			OnlineOptions: OnlineOptions{Timeout: time.Second, Interval: 10 * time.Millisecond},
+			ShutdownGrace: time.Second,
+		},
+	}
+}
+
+func TestConnector_UpgradeFirmware(t *testing.T) {
+	uploaded := 0
+	ts := mockUpgradeServer(t, "V2.0", &uploaded)
+	defer ts.Close()
+
+	firmware := []byte(strings.Repeat("x", 1000))
+	var progress []FirmwareProgress
+	opts := mockFirmwareOptions()
+	opts.Size = int64(len(firmware))
+	opts.ExpectedVersion = "V2.0"
+	opts.Progress = func(p FirmwareProgress) { progress = append(progress, p) }
+	c := Connector{Host: ts.URL[7:], User: "mock-user"}
+	info, err := c.UpgradeFirmware(context.Background(), bytes.NewReader(firmware), opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "V2.0", info.FirmwareVersion)
+	assert.Equal(t, len(firmware), uploaded)
+	assert.Equal(t, FirmwareProgress{Stage: FirmwareStageUpload, Uploaded: int64(len(firmware)), Percent: 100}, progress[0])
+	assert.Equal(t, []FirmwareProgress{
+		{Stage: FirmwareStageUpgrade, Percent: 0},
+		{Stage: FirmwareStageUpgrade, Percent: 50},
+		{Stage: FirmwareStageUpgrade, Percent: 100},
+		{Stage: FirmwareStageReboot},
+	}, progress[len(progress)-4:])
+}
+
+func TestConnector_UpgradeFirmwareVersionCheck(t *testing.T) {
+	uploaded := 0
+	ts := mockUpgradeServer(t, "V1.0", &uploaded)
+	defer ts.Close()
+	c := Connector{Host: ts.URL[7:], User: "mock-user"}
+
+	info, err := c.UpgradeFirmware(context.Background(), strings.NewReader("mock-firmware"), mockFirmwareOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, "V1.0", info.FirmwareVersion)
+
+	opts := mockFirmwareOptions()
+	opts.ExpectedVersion = "V2.0"
+	_, err = c.UpgradeFirmware(context.Background(), strings.NewReader("mock-firmware"), opts)
+	assert.EqualError(t, err, "Error verifying firmware, expected version V2.0 got V1.0")
+}
+
+func mockHeaderModels(header []byte) ([]string, bool) {
+	line, _, _ := strings.Cut(string(header), "\n")
+	list, found := strings.CutPrefix(line, "models=")
+	if !found {
+		return nil, false
+	}
+	return strings.Split(list, ","), true
+}
+
+func TestConnector_UpgradeFirmwareModelMismatch(t *testing.T) {
+	uploaded := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case deviceInfoPath:
+			fmt.Fprintf(w, mockDeviceInfo, "V1.0")
+		case updateFirmwarePath:
+			uploaded = true
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:], User: "mock-user"}
+	_, err := c.UpgradeFirmware(context.Background(), strings.NewReader("models=DS-OTHER,DS-2CD*\n"), FirmwareOptions{HeaderModels: mockHeaderModels})
+	assert.EqualError(t, err, "invalid parameter firmware: image is built for DS-OTHER, DS-2CD*, device is DS-MOCK")
+	assert.False(t, uploaded)
+}
+
+func TestCheckFirmwareModel(t *testing.T) {
+	assert.Nil(t, checkFirmwareModel([]byte("models=DS-OTHER\n"), "DS-MOCK", nil))
+	assert.Nil(t, checkFirmwareModel([]byte{0x00, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x3d, 0xff}, "DS-MOCK", mockHeaderModels))
+	assert.Nil(t, checkFirmwareModel([]byte("models=DS-2CD2*,DS-2DE*\n"), "ds-2cd2143g0-i", mockHeaderModels))
+	assert.Nil(t, checkFirmwareModel([]byte("models=DS-MOCK\n"), "DS-MOCK", mockHeaderModels))
+	assert.EqualError(t, checkFirmwareModel([]byte("models=DS-2DE*\n"), "DS-2CD2143G0-I", mockHeaderModels),
+		"invalid parameter firmware: image is built for DS-2DE*, device is DS-2CD2143G0-I")
+}
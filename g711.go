@@ -0,0 +1,196 @@
+package annkesdk
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+type wavFormat struct {
+	channels      int
+	sampleRate    int
+	bitsPerSample int
+	blockAlign    int
+}
+
+type mulawEncoder struct {
+	r       io.Reader
+	format  wavFormat
+	frame   []byte
+	step    float64
+	pos     float64
+	index   int64
+	current float64
+	next    float64
+	started bool
+	last    bool
+	err     error
+}
+
+func (c Connector) PlayWAV(ctx context.Context, channel int, wav io.Reader) error {
+	audio, err := NewMulawEncoder(wav)
+	if err != nil {
+		return err
+	}
+	return c.PlayAudio(ctx, channel, models.AudioCompressionG711ulaw, audio)
+}
+
+func NewMulawEncoder(wav io.Reader) (io.Reader, error) {
+	r := bufio.NewReader(wav)
+	format, dataLength, err := readWAVHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	var data io.Reader = r
+	if dataLength != 0 && dataLength != wavUnknownLength {
+		data = io.LimitReader(r, int64(dataLength))
+	}
+	return &mulawEncoder{
+		r:      data,
+		format: format,
+		frame:  make([]byte, format.blockAlign),
+		step:   float64(format.sampleRate) / mulawSampleRate,
+	}, nil
+}
+
+func readWAVHeader(r io.Reader) (wavFormat, uint32, error) {
+	format := wavFormat{}
+	header := make([]byte, wavHeaderLength)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return format, 0, fmt.Errorf("Error reading WAV header %w", err)
+	}
+	if string(header[0:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
+		return format, 0, NewAnnkeValidationError("wav", "not a RIFF/WAVE file")
+	}
+
+	chunk := make([]byte, wavChunkHeader)
+	for {
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return format, 0, fmt.Errorf("Error reading WAV chunk %w", err)
+		}
+		id, size := string(chunk[0:4]), binary.LittleEndian.Uint32(chunk[4:8])
+		switch id {
+		case "fmt ":
+			if size < wavFmtMinLength {
+				return format, 0, NewAnnkeValidationError("wav", "fmt chunk is too short")
+			}
+			body := make([]byte, size+size%2)
+			if _, err := io.ReadFull(r, body); err != nil {
+				return format, 0, fmt.Errorf("Error reading WAV format %w", err)
+			}
+			if binary.LittleEndian.Uint16(body[0:2]) != wavFormatPCM {
+				return format, 0, NewAnnkeValidationError("wav", "only PCM encoded files are supported")
+			}
+			format.channels = int(binary.LittleEndian.Uint16(body[2:4]))
+			format.sampleRate = int(binary.LittleEndian.Uint32(body[4:8]))
+			format.blockAlign = int(binary.LittleEndian.Uint16(body[12:14]))
+			format.bitsPerSample = int(binary.LittleEndian.Uint16(body[14:16]))
+		case "data":
+			if format.sampleRate == 0 {
+				return format, 0, NewAnnkeValidationError("wav", "data chunk found before fmt chunk")
+			}
+			if format.bitsPerSample != 8 && format.bitsPerSample != 16 {
+				return format, 0, NewAnnkeValidationError("wav", fmt.Sprintf("unsupported %d bits per sample", format.bitsPerSample))
+			}
+			if format.channels == 0 || format.blockAlign != format.channels*format.bitsPerSample/8 {
+				return format, 0, NewAnnkeValidationError("wav", "invalid channel layout")
+			}
+			return format, size, nil
+		default:
+			if _, err := io.CopyN(io.Discard, r, int64(size+size%2)); err != nil {
+				return format, 0, fmt.Errorf("Error skipping WAV chunk %s %w", id, err)
+			}
+		}
+	}
+}
+
+func (e *mulawEncoder) Read(b []byte) (int, error) {
+	if !e.started {
+		e.started = true
+		sample, err := e.readFrame()
+		if err != nil {
+			return 0, err
+		}
+		e.current = sample
+		e.advanceNext()
+	}
+
+	n := 0
+	for n < len(b) {
+		for int64(e.pos) > e.index {
+			if e.last {
+				if e.err != nil {
+					return n, e.err
+				}
+				if n == 0 {
+					return 0, io.EOF
+				}
+				return n, nil
+			}
+			e.current = e.next
+			e.index++
+			e.advanceNext()
+		}
+		fraction := e.pos - float64(e.index)
+		b[n] = linearToMulaw(int16(e.current + (e.next-e.current)*fraction))
+		e.pos += e.step
+		n++
+	}
+	return n, nil
+}
+
+func (e *mulawEncoder) advanceNext() {
+	sample, err := e.readFrame()
+	if err != nil {
+		e.next = e.current
+		e.last = true
+		if err != io.EOF {
+			e.err = err
+		}
+		return
+	}
+	e.next = sample
+}
+
+func (e *mulawEncoder) readFrame() (float64, error) {
+	if _, err := io.ReadFull(e.r, e.frame); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, io.EOF
+		}
+		return 0, err
+	}
+	sum := 0
+	for channel := 0; channel < e.format.channels; channel++ {
+		if e.format.bitsPerSample == 8 {
+			sum += (int(e.frame[channel]) - 128) << 8
+		} else {
+			sum += int(int16(binary.LittleEndian.Uint16(e.frame[channel*2:])))
+		}
+	}
+	return float64(sum) / float64(e.format.channels), nil
+}
+
+func linearToMulaw(sample int16) byte {
+	value := int32(sample)
+	sign := int32(0)
+	if value < 0 {
+		sign = 0x80
+		value = -value
+	}
+	if value > mulawClip {
+		value = mulawClip
+	}
+	value += mulawBias
+
+	exponent := int32(7)
+	for mask := int32(0x4000); value&mask == 0 && exponent > 0; mask >>= 1 {
+		exponent--
+	}
+	mantissa := (value >> (exponent + 3)) & 0x0F
+	return ^byte(sign | exponent<<4 | mantissa)
+}
@@ -0,0 +1,103 @@
+package annkesdk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mockWAV(format uint16, channels uint16, sampleRate uint32, bits uint16, samples []int16) []byte {
+	data := &bytes.Buffer{}
+	for _, sample := range samples {
+		binary.Write(data, binary.LittleEndian, sample)
+	}
+	blockAlign := channels * bits / 8
+
+	wav := &bytes.Buffer{}
+	wav.WriteString("RIFF")
+	binary.Write(wav, binary.LittleEndian, uint32(36+data.Len()))
+	wav.WriteString("WAVE")
+	wav.WriteString("LIST")
+	binary.Write(wav, binary.LittleEndian, uint32(3))
+	wav.Write([]byte{1, 2, 3, 0})
+	wav.WriteString("fmt ")
+	binary.Write(wav, binary.LittleEndian, uint32(16))
+	binary.Write(wav, binary.LittleEndian, format)
+	binary.Write(wav, binary.LittleEndian, channels)
+	binary.Write(wav, binary.LittleEndian, sampleRate)
+	binary.Write(wav, binary.LittleEndian, sampleRate*uint32(blockAlign))
+	binary.Write(wav, binary.LittleEndian, blockAlign)
+	binary.Write(wav, binary.LittleEndian, bits)
+	wav.WriteString("data")
+	binary.Write(wav, binary.LittleEndian, uint32(data.Len()))
+	wav.Write(data.Bytes())
+	return wav.Bytes()
+}
+
+func TestLinearToMulaw(t *testing.T) {
+	cases := []struct {
+		sample   int16
+		expected byte
+	}{
+		{0, 0xFF},
+		{-1, 0x7F},
+		{32767, 0x80},
+		{-32768, 0x00},
+		{1000, 0xCE},
+		{-1000, 0x4E},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, linearToMulaw(tc.sample), "sample %d", tc.sample)
+	}
+}
+
+func TestNewMulawEncoder(t *testing.T) {
+	cases := []struct {
+		name          string
+		wav           []byte
+		expected      []byte
+		expectedError string
+	}{
+		{
+			name:     "8kHz mono",
+			wav:      mockWAV(1, 1, 8000, 16, []int16{0, 1000, -1000, 32767}),
+			expected: []byte{0xFF, 0xCE, 0x4E, 0x80},
+		},
+		{
+			name:     "16kHz mono is downsampled",
+			wav:      mockWAV(1, 1, 16000, 16, []int16{0, 5, 1000, 5, -1000, 5}),
+			expected: []byte{0xFF, 0xCE, 0x4E},
+		},
+		{
+			name:     "8kHz stereo is downmixed",
+			wav:      mockWAV(1, 2, 8000, 16, []int16{2000, 0, -1000, -1000}),
+			expected: []byte{0xCE, 0x4E},
+		},
+		{
+			name:          "not a wav file",
+			wav:           []byte("mock-file-content"),
+			expectedError: "invalid parameter wav: not a RIFF/WAVE file",
+		},
+		{
+			name:          "compressed wav",
+			wav:           mockWAV(3, 1, 8000, 16, nil),
+			expectedError: "invalid parameter wav: only PCM encoded files are supported",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			encoder, err := NewMulawEncoder(bytes.NewReader(tc.wav))
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.Nil(t, err)
+			encoded, err := io.ReadAll(encoder)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, encoded)
+		})
+	}
+}
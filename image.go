@@ -0,0 +1,108 @@
+package annkesdk
+
+import "github.com/csrar/annkeSDK/models"
+
+func (c Connector) GetImageChannel(channel int) (models.ImageChannel, error) {
+	imageChannel := models.ImageChannel{}
+	err := c.makeGetRequest(getImageChannelPath(channel), &imageChannel)
+	return imageChannel, err
+}
+
+func (c Connector) GetImageCapabilities(channel int) (models.ImageChannelCapabilities, error) {
+	capabilities := models.ImageChannelCapabilities{}
+	err := c.makeGetRequest(getImageSettingPath(channel, "capabilities"), &capabilities)
+	return capabilities, err
+}
+
+func (c Connector) GetImageColor(channel int) (models.Color, error) {
+	color := models.Color{}
+	err := c.makeGetRequest(getImageSettingPath(channel, "color"), &color)
+	return color, err
+}
+
+func (c Connector) GetIrcutFilter(channel int) (models.IrcutFilter, error) {
+	ircutFilter := models.IrcutFilter{}
+	err := c.makeGetRequest(getImageSettingPath(channel, "IrcutFilter"), &ircutFilter)
+	return ircutFilter, err
+}
+
+func (c Connector) GetExposure(channel int) (models.Exposure, error) {
+	exposure := models.Exposure{}
+	err := c.makeGetRequest(getImageSettingPath(channel, "exposure"), &exposure)
+	return exposure, err
+}
+
+func (c Connector) GetWDR(channel int) (models.WDR, error) {
+	wdr := models.WDR{}
+	err := c.makeGetRequest(getImageSettingPath(channel, "WDR"), &wdr)
+	return wdr, err
+}
+
+func (c Connector) GetBLC(channel int) (models.BLC, error) {
+	blc := models.BLC{}
+	err := c.makeGetRequest(getImageSettingPath(channel, "BLC"), &blc)
+	return blc, err
+}
+
+func (c Connector) GetHLC(channel int) (models.HLC, error) {
+	hlc := models.HLC{}
+	err := c.makeGetRequest(getImageSettingPath(channel, "HLC"), &hlc)
+	return hlc, err
+}
+
+func (c Connector) GetWhiteBalance(channel int) (models.WhiteBalance, error) {
+	whiteBalance := models.WhiteBalance{}
+	err := c.makeGetRequest(getImageSettingPath(channel, "whiteBalance"), &whiteBalance)
+	return whiteBalance, err
+}
+
+func (c Connector) GetNoiseReduce(channel int) (models.NoiseReduce, error) {
+	noiseReduce := models.NoiseReduce{}
+	err := c.makeGetRequest(getImageSettingPath(channel, "noiseReduce"), &noiseReduce)
+	return noiseReduce, err
+}
+
+func (c Connector) UpdateImageChannel(channel int, imageChannel models.ImageChannel) error {
+	return c.makeUpdateRequest(getImageChannelPath(channel), imageChannel)
+}
+
+func (c Connector) UpdateImageColor(channel int, color models.Color) error {
+	return c.makeUpdateRequest(getImageSettingPath(channel, "color"), color)
+}
+
+func (c Connector) UpdateIrcutFilter(channel int, ircutFilter models.IrcutFilter) error {
+	return c.makeUpdateRequest(getImageSettingPath(channel, "IrcutFilter"), ircutFilter)
+}
+
+func (c Connector) UpdateExposure(channel int, exposure models.Exposure) error {
+	return c.makeUpdateRequest(getImageSettingPath(channel, "exposure"), exposure)
+}
+
+func (c Connector) UpdateWDR(channel int, wdr models.WDR) error {
+	return c.makeUpdateRequest(getImageSettingPath(channel, "WDR"), wdr)
+}
+
+func (c Connector) UpdateBLC(channel int, blc models.BLC) error {
+	return c.makeUpdateRequest(getImageSettingPath(channel, "BLC"), blc)
+}
+
+func (c Connector) UpdateHLC(channel int, hlc models.HLC) error {
+	return c.makeUpdateRequest(getImageSettingPath(channel, "HLC"), hlc)
+}
+
+func (c Connector) UpdateWhiteBalance(channel int, whiteBalance models.WhiteBalance) error {
+	return c.makeUpdateRequest(getImageSettingPath(channel, "whiteBalance"), whiteBalance)
+}
+
+func (c Connector) UpdateNoiseReduce(channel int, noiseReduce models.NoiseReduce) error {
+	return c.makeUpdateRequest(getImageSettingPath(channel, "noiseReduce"), noiseReduce)
+}
+
+func (c Connector) SetDayNightMode(channel int, mode models.IrcutFilterType) error {
+	ircutFilter, err := c.GetIrcutFilter(channel)
+	if err != nil {
+		return err
+	}
+	ircutFilter.IrcutFilterType = mode
+	return c.UpdateIrcutFilter(channel, ircutFilter)
+}
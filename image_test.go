@@ -0,0 +1,49 @@
+package annkesdk
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_SetDayNightMode(t *testing.T) {
+	var updated string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ISAPI/Image/channels/1/IrcutFilter", r.URL.Path)
+		if r.Method == "PUT" {
+			body, _ := io.ReadAll(r.Body)
+			updated = string(body)
+			return
+		}
+		fmt.Fprint(w, "<IrcutFilter><IrcutFilterType>auto</IrcutFilterType><nightToDayFilterLevel>4</nightToDayFilterLevel><nightToDayFilterTime>5</nightToDayFilterTime></IrcutFilter>")
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	assert.Nil(t, c.SetDayNightMode(1, models.IrcutFilterNight))
+	assert.Equal(t, "<IrcutFilter><IrcutFilterType>night</IrcutFilterType><nightToDayFilterLevel>4</nightToDayFilterLevel><nightToDayFilterTime>5</nightToDayFilterTime></IrcutFilter>", updated)
+}
+
+func TestConnector_GetImageCapabilities(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ISAPI/Image/channels/1/capabilities", r.URL.Path)
+		fmt.Fprint(w, `<ImageChannel version="2.0"><id>1</id><Color><brightnessLevel min="0" max="100"/></Color><IrcutFilter><IrcutFilterType opt="auto,day,night"/></IrcutFilter><WDR><mode opt="open,close"/></WDR></ImageChannel>`)
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	caps, err := c.GetImageCapabilities(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "auto,day,night", caps.IrcutFilter.IrcutFilterType.Opt)
+	assert.True(t, caps.Supports(models.ImageFeatureColor))
+	assert.True(t, caps.Supports(models.ImageFeatureIrcutFilter))
+	assert.True(t, caps.Supports(models.ImageFeatureWDR))
+	assert.False(t, caps.Supports(models.ImageFeatureHLC))
+	assert.False(t, caps.Supports(models.ImageFeatureNoiseReduce))
+	assert.False(t, caps.Supports(models.ImageFeature("mock")))
+}
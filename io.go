@@ -0,0 +1,56 @@
+package annkesdk
+
+import "github.com/csrar/annkeSDK/models"
+
+func (c Connector) GetIOInputs() (models.IOInputPortList, error) {
+	inputs := models.IOInputPortList{}
+	err := c.makeGetRequest(ioInputsPath, &inputs)
+	return inputs, err
+}
+
+func (c Connector) GetIOInput(id int) (models.IOInputPort, error) {
+	input := models.IOInputPort{}
+	err := c.makeGetRequest(getIOInputPath(id), &input)
+	return input, err
+}
+
+func (c Connector) GetIOInputTrigger(id int) (models.EventTrigger, error) {
+	return c.GetEventTriggerFor(models.EventTypeIO, id)
+}
+
+func (c Connector) GetIOOutputs() (models.IOOutputPortList, error) {
+	outputs := models.IOOutputPortList{}
+	err := c.makeGetRequest(ioOutputsPath, &outputs)
+	return outputs, err
+}
+
+func (c Connector) GetIOOutput(id int) (models.IOOutputPort, error) {
+	output := models.IOOutputPort{}
+	err := c.makeGetRequest(getIOOutputPath(id), &output)
+	return output, err
+}
+
+func (c Connector) GetIOStatus() (models.IOPortStatusList, error) {
+	status := models.IOPortStatusList{}
+	err := c.makeGetRequest(ioStatusPath, &status)
+	return status, err
+}
+
+func (c Connector) UpdateIOInput(id int, input models.IOInputPort) error {
+	return c.makeUpdateRequest(getIOInputPath(id), input)
+}
+
+func (c Connector) UpdateIOInputTrigger(id int, trigger models.EventTrigger) error {
+	return c.UpdateEventTriggerFor(models.EventTypeIO, id, trigger)
+}
+
+func (c Connector) UpdateIOOutput(id int, output models.IOOutputPort) error {
+	return c.makeUpdateRequest(getIOOutputPath(id), output)
+}
+
+func (c Connector) TriggerOutput(id int, state models.IOOutputState) error {
+	if state != models.IOOutputStateHigh && state != models.IOOutputStateLow {
+		return NewAnnkeValidationError("state", "output can only be triggered high or low")
+	}
+	return c.makeUpdateRequest(getIOOutputTriggerPath(id), models.IOPortData{OutputState: state})
+}
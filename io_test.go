@@ -0,0 +1,29 @@
+package annkesdk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_TriggerOutput(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+	}))
+	defer ts.Close()
+	c := Connector{Host: ts.URL[7:]}
+
+	assert.Nil(t, c.TriggerOutput(1, models.IOOutputStateHigh))
+	assert.Nil(t, c.TriggerOutput(2, models.IOOutputStateLow))
+	assert.EqualError(t, c.TriggerOutput(1, models.IOOutputStatePulse), "invalid parameter state: output can only be triggered high or low")
+	assert.Equal(t, []string{
+		"PUT /ISAPI/System/IO/outputs/1/trigger <IOPortData><outputState>high</outputState></IOPortData>",
+		"PUT /ISAPI/System/IO/outputs/2/trigger <IOPortData><outputState>low</outputState></IOPortData>",
+	}, requests)
+}
@@ -0,0 +1,66 @@
+package annkesdk
+
+import (
+	"context"
+	"time"
+)
+
+type FactoryResetMode string
+
+const (
+	FactoryResetBasic FactoryResetMode = "basic"
+	FactoryResetFull  FactoryResetMode = "full"
+)
+
+type RebootOptions struct {
+	OnlineOptions
+	ShutdownGrace time.Duration
+}
+
+func (c Connector) Reboot(ctx context.Context) error {
+	return c.makeRequest(ctx, "PUT", rebootPath, nil, nil)
+}
+
+func (c Connector) RebootAndWait(ctx context.Context, opts RebootOptions) error {
+	if err := c.Reboot(ctx); err != nil {
+		return err
+	}
+	if err := c.waitOffline(ctx, opts); err != nil {
+		return err
+	}
+	return c.WaitOnline(ctx, opts.OnlineOptions)
+}
+
+func (c Connector) FactoryReset(ctx context.Context, mode FactoryResetMode) error {
+	if mode != FactoryResetBasic && mode != FactoryResetFull {
+		return NewAnnkeValidationError("mode", "must be basic or full")
+	}
+	return c.makeRequest(ctx, "PUT", getFactoryResetPath(string(mode)), nil, nil)
+}
+
+func (c Connector) waitOffline(ctx context.Context, opts RebootOptions) error {
+	grace := opts.ShutdownGrace
+	if grace <= 0 {
+		grace = defaultShutdownGrace
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultOnlineInterval
+	}
+	deadline := time.NewTimer(grace)
+	defer deadline.Stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := c.login(); err != nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline.C:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package annkesdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_RebootAndWait(t *testing.T) {
+	var mu sync.Mutex
+	rebooted, offlineLogins, logins := false, 0, 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case rebootPath:
+			assert.Equal(t, "PUT", r.Method)
+			rebooted = true
+		case loginPath:
+			logins++
+			if rebooted && offlineLogins < 2 {
+				offlineLogins++
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			fmt.Fprint(w, mockLoginCapabilities)
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:], User: "mock-user"}
+	err := c.RebootAndWait(context.Background(), RebootOptions{
+		OnlineOptions: OnlineOptions{Timeout: time.Second, Interval: 10 * time.Millisecond},
+		ShutdownGrace: time.Second,
+	})
+	assert.Nil(t, err)
+	assert.True(t, rebooted)
+	assert.Equal(t, 2, offlineLogins)
+	assert.Equal(t, 3, logins)
+}
+
+func TestConnector_FactoryResetInvalidMode(t *testing.T) {
+	c := Connector{Host: "localhost:9999"}
+	err := c.FactoryReset(context.Background(), FactoryResetMode("mock"))
+	assert.EqualError(t, err, "invalid parameter mode: must be basic or full")
+}
@@ -0,0 +1,55 @@
+package models
+
+import "encoding/xml"
+
+type AudioCompression string
+
+const (
+	AudioCompressionG711ulaw AudioCompression = "G.711ulaw"
+	AudioCompressionG711alaw AudioCompression = "G.711alaw"
+	AudioCompressionG726     AudioCompression = "G.726"
+)
+
+type AudioChannelList struct {
+	XMLName      xml.Name       `xml:"AudioChannelList"`
+	Version      string         `xml:"version,attr"`
+	AudioChannel []AudioChannel `xml:"AudioChannel"`
+}
+
+type AudioChannel struct {
+	XMLName          xml.Name `xml:"AudioChannel"`
+	Version          string   `xml:"version,attr,omitempty"`
+	Xmlns            string   `xml:"xmlns,attr,omitempty"`
+	ID               string   `xml:"id"`
+	Enabled          bool     `xml:"enabled"`
+	AudioMode        string   `xml:"audioMode,omitempty"`
+	MicrophoneVolume int      `xml:"microphoneVolume,omitempty"`
+	SpeakerVolume    int      `xml:"speakerVolume,omitempty"`
+	NoiseReduce      bool     `xml:"noisereduce"`
+}
+
+type TwoWayAudioChannelList struct {
+	XMLName            xml.Name             `xml:"TwoWayAudioChannelList"`
+	Version            string               `xml:"version,attr"`
+	TwoWayAudioChannel []TwoWayAudioChannel `xml:"TwoWayAudioChannel"`
+}
+
+type TwoWayAudioChannel struct {
+	XMLName              xml.Name         `xml:"TwoWayAudioChannel"`
+	Version              string           `xml:"version,attr,omitempty"`
+	Xmlns                string           `xml:"xmlns,attr,omitempty"`
+	ID                   string           `xml:"id"`
+	Enabled              bool             `xml:"enabled"`
+	AudioCompressionType AudioCompression `xml:"audioCompressionType"`
+	AudioInputType       string           `xml:"audioInputType,omitempty"`
+	SpeakerVolume        int              `xml:"speakerVolume,omitempty"`
+	MicrophoneVolume     int              `xml:"microphoneVolume,omitempty"`
+	NoiseReduce          bool             `xml:"noisereduce"`
+	AudioBitRate         int              `xml:"audioBitRate,omitempty"`
+	AudioSamplingRate    string           `xml:"audioSamplingRate,omitempty"`
+}
+
+type TwoWayAudioSession struct {
+	XMLName   xml.Name `xml:"TwoWayAudioSession"`
+	SessionID string   `xml:"sessionId"`
+}
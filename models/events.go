@@ -3,19 +3,27 @@ package models
 import "encoding/xml"
 
 type VideoInputChannelList struct {
-	XMLName           xml.Name `xml:"VideoInputChannelList"`
+	XMLName           xml.Name            `xml:"VideoInputChannelList"`
+	Text              string              `xml:",chardata"`
+	Version           string              `xml:"version,attr"`
+	VideoInputChannel []VideoInputChannel `xml:"VideoInputChannel"`
+}
+
+type VideoInputChannel struct {
+	XMLName           xml.Name `xml:"VideoInputChannel"`
 	Text              string   `xml:",chardata"`
-	Version           string   `xml:"version,attr"`
-	VideoInputChannel []struct {
-		Text              string `xml:",chardata"`
-		Version           string `xml:"version,attr"`
-		ID                string `xml:"id"`
-		InputPort         string `xml:"inputPort"`
-		VideoInputEnabled string `xml:"videoInputEnabled"`
-		Name              string `xml:"name"`
-		VideoFormat       string `xml:"videoFormat"`
-		ResDesc           string `xml:"resDesc"`
-	} `xml:"VideoInputChannel"`
+	Version           string   `xml:"version,attr,omitempty"`
+	ID                string   `xml:"id"`
+	InputPort         string   `xml:"inputPort"`
+	VideoInputEnabled string   `xml:"videoInputEnabled"`
+	Name              string   `xml:"name"`
+	VideoFormat       string   `xml:"videoFormat"`
+	ResDesc           string   `xml:"resDesc"`
+}
+
+type ChannelUpdate struct {
+	Name    *string
+	Enabled *bool
 }
 
 type MotionDetection struct {
@@ -61,28 +69,93 @@ type MotionSchedule struct {
 	EventType           string   `xml:"eventType"`
 	VideoInputChannelID string   `xml:"videoInputChannelID"`
 	TimeBlockList       struct {
-		Size      string `xml:"size,attr"`
-		TimeBlock []struct {
-			DayOfWeek string `xml:"dayOfWeek"`
-			TimeRange struct {
-				BeginTime string `xml:"beginTime"`
-				EndTime   string `xml:"endTime"`
-			} `xml:"TimeRange"`
-		} `xml:"TimeBlock"`
+		Size      string      `xml:"size,attr"`
+		TimeBlock []TimeBlock `xml:"TimeBlock"`
 	} `xml:"TimeBlockList"`
 	HolidayBlockList string `xml:"HolidayBlockList"`
 }
 
+type TimeBlock struct {
+	DayOfWeek string `xml:"dayOfWeek"`
+	TimeRange struct {
+		BeginTime string `xml:"beginTime"`
+		EndTime   string `xml:"endTime"`
+	} `xml:"TimeRange"`
+}
+
 type EventTrigger struct {
 	XMLName                      xml.Name `xml:"EventTrigger"`
 	ID                           string   `xml:"id"`
 	EventType                    string   `xml:"eventType"`
 	VideoInputChannelID          string   `xml:"videoInputChannelID"`
 	EventTriggerNotificationList struct {
-		EventTriggerNotification []struct {
-			ID                 string `xml:"id"`
-			NotificationMethod string `xml:"notificationMethod"`
-			VideoInputID       string `xml:"videoInputID"`
-		} `xml:"EventTriggerNotification"`
+		EventTriggerNotification []EventTriggerNotification `xml:"EventTriggerNotification"`
 	} `xml:"EventTriggerNotificationList"`
 }
+
+type EventTriggerNotification struct {
+	ID                 string     `xml:"id"`
+	NotificationMethod string     `xml:"notificationMethod"`
+	VideoInputID       string     `xml:"videoInputID"`
+	PTZAction          *PTZAction `xml:"ptzAction,omitempty"`
+}
+
+type EventType string
+
+const (
+	EventTypeVMD                  EventType = "VMD"
+	EventTypeTamper               EventType = "tamper"
+	EventTypeVideoLoss            EventType = "videoloss"
+	EventTypeLineDetection        EventType = "linedetection"
+	EventTypeFieldDetection       EventType = "fielddetection"
+	EventTypeRegionEntrance       EventType = "regionEntrance"
+	EventTypeRegionExiting        EventType = "regionExiting"
+	EventTypeSceneChangeDetection EventType = "scenechangedetection"
+	EventTypeFace                 EventType = "facedetection"
+	EventTypeIO                   EventType = "IO"
+)
+
+type EventTriggerList struct {
+	XMLName      xml.Name       `xml:"EventTriggerList"`
+	Version      string         `xml:"version,attr"`
+	EventTrigger []EventTrigger `xml:"EventTrigger"`
+}
+
+type TamperDetection struct {
+	XMLName                   xml.Name              `xml:"TamperDetection"`
+	Version                   string                `xml:"version,attr,omitempty"`
+	Xmlns                     string                `xml:"xmlns,attr,omitempty"`
+	Enabled                   bool                  `xml:"enabled"`
+	NormalizedScreenSize      *NormalizedScreenSize `xml:"normalizedScreenSize,omitempty"`
+	TamperDetectionRegionList struct {
+		TamperDetectionRegion []struct {
+			ID                    string                `xml:"id"`
+			Enabled               bool                  `xml:"enabled"`
+			SensitivityLevel      int                   `xml:"sensitivityLevel"`
+			RegionCoordinatesList RegionCoordinatesList `xml:"RegionCoordinatesList"`
+		} `xml:"TamperDetectionRegion"`
+	} `xml:"TamperDetectionRegionList"`
+}
+
+type VideoLoss struct {
+	XMLName xml.Name `xml:"VideoLoss"`
+	Version string   `xml:"version,attr,omitempty"`
+	Xmlns   string   `xml:"xmlns,attr,omitempty"`
+	Enabled bool     `xml:"enabled"`
+}
+
+type VideoInputChannelStatusList struct {
+	XMLName                 xml.Name `xml:"VideoInputChannelStatusList"`
+	Version                 string   `xml:"version,attr"`
+	VideoInputChannelStatus []struct {
+		ID     string `xml:"id"`
+		Online bool   `xml:"online"`
+	} `xml:"VideoInputChannelStatus"`
+}
+
+type ChannelStatus struct {
+	ID      string
+	Name    string
+	Enabled bool
+	Online  *bool
+}
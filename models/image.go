@@ -0,0 +1,201 @@
+package models
+
+import "encoding/xml"
+
+type IrcutFilterType string
+
+const (
+	IrcutFilterDay      IrcutFilterType = "day"
+	IrcutFilterNight    IrcutFilterType = "night"
+	IrcutFilterAuto     IrcutFilterType = "auto"
+	IrcutFilterSchedule IrcutFilterType = "schedule"
+)
+
+type ExposureType string
+
+const (
+	ExposureAuto         ExposureType = "auto"
+	ExposureIrisFirst    ExposureType = "IrisFirst"
+	ExposureShutterFirst ExposureType = "ShutterFirst"
+	ExposureGainFirst    ExposureType = "GainFirst"
+	ExposureManual       ExposureType = "manual"
+)
+
+type WDRMode string
+
+const (
+	WDRModeOpen  WDRMode = "open"
+	WDRModeClose WDRMode = "close"
+	WDRModeAuto  WDRMode = "auto"
+)
+
+type BLCMode string
+
+const (
+	BLCModeUp     BLCMode = "up"
+	BLCModeDown   BLCMode = "down"
+	BLCModeLeft   BLCMode = "left"
+	BLCModeRight  BLCMode = "right"
+	BLCModeCenter BLCMode = "center"
+	BLCModeRegion BLCMode = "region"
+)
+
+type WhiteBalanceStyle string
+
+const (
+	WhiteBalanceAuto           WhiteBalanceStyle = "auto"
+	WhiteBalanceAutoTrack      WhiteBalanceStyle = "autoTrack"
+	WhiteBalanceManual         WhiteBalanceStyle = "manual"
+	WhiteBalanceLocked         WhiteBalanceStyle = "locked"
+	WhiteBalanceIndoor         WhiteBalanceStyle = "indoor"
+	WhiteBalanceOutdoor        WhiteBalanceStyle = "outdoor"
+	WhiteBalanceFluorescent    WhiteBalanceStyle = "fluorescentLamp"
+	WhiteBalanceSodiumLamp     WhiteBalanceStyle = "sodiumlight"
+	WhiteBalanceIncandescent   WhiteBalanceStyle = "incandescentLamp"
+	WhiteBalanceWarmLight      WhiteBalanceStyle = "warmLight"
+	WhiteBalanceNaturalLight   WhiteBalanceStyle = "naturalLight"
+	WhiteBalanceAutoOutdoor    WhiteBalanceStyle = "autoOutdoor"
+	WhiteBalanceAutoSodiumLamp WhiteBalanceStyle = "autoSodiumlight"
+)
+
+type NoiseReduceMode string
+
+const (
+	NoiseReduceClose    NoiseReduceMode = "close"
+	NoiseReduceGeneral  NoiseReduceMode = "general"
+	NoiseReduceAdvanced NoiseReduceMode = "advanced"
+)
+
+type ImageFeature string
+
+const (
+	ImageFeatureColor        ImageFeature = "Color"
+	ImageFeatureIrcutFilter  ImageFeature = "IrcutFilter"
+	ImageFeatureExposure     ImageFeature = "Exposure"
+	ImageFeatureWDR          ImageFeature = "WDR"
+	ImageFeatureBLC          ImageFeature = "BLC"
+	ImageFeatureHLC          ImageFeature = "HLC"
+	ImageFeatureWhiteBalance ImageFeature = "WhiteBalance"
+	ImageFeatureNoiseReduce  ImageFeature = "NoiseReduce"
+)
+
+type ImageChannel struct {
+	XMLName      xml.Name      `xml:"ImageChannel"`
+	Version      string        `xml:"version,attr,omitempty"`
+	Xmlns        string        `xml:"xmlns,attr,omitempty"`
+	ID           string        `xml:"id"`
+	Enabled      bool          `xml:"enabled"`
+	VideoInputID string        `xml:"videoInputID"`
+	Color        *Color        `xml:"Color,omitempty"`
+	IrcutFilter  *IrcutFilter  `xml:"IrcutFilter,omitempty"`
+	Exposure     *Exposure     `xml:"Exposure,omitempty"`
+	WDR          *WDR          `xml:"WDR,omitempty"`
+	BLC          *BLC          `xml:"BLC,omitempty"`
+	HLC          *HLC          `xml:"HLC,omitempty"`
+	WhiteBalance *WhiteBalance `xml:"WhiteBalance,omitempty"`
+	NoiseReduce  *NoiseReduce  `xml:"NoiseReduce,omitempty"`
+}
+
+type Color struct {
+	XMLName         xml.Name `xml:"Color"`
+	BrightnessLevel int      `xml:"brightnessLevel"`
+	ContrastLevel   int      `xml:"contrastLevel"`
+	SaturationLevel int      `xml:"saturationLevel"`
+	HueLevel        int      `xml:"hueLevel,omitempty"`
+}
+
+type IrcutFilter struct {
+	XMLName               xml.Name        `xml:"IrcutFilter"`
+	IrcutFilterType       IrcutFilterType `xml:"IrcutFilterType"`
+	NightToDayFilterLevel int             `xml:"nightToDayFilterLevel,omitempty"`
+	NightToDayFilterTime  int             `xml:"nightToDayFilterTime,omitempty"`
+	Schedule              *struct {
+		ScheduleType string `xml:"scheduleType"`
+		TimeRange    struct {
+			BeginTime string `xml:"beginTime"`
+			EndTime   string `xml:"endTime"`
+		} `xml:"TimeRange"`
+	} `xml:"Schedule,omitempty"`
+}
+
+type Exposure struct {
+	XMLName      xml.Name     `xml:"Exposure"`
+	ExposureType ExposureType `xml:"ExposureType"`
+}
+
+type WDR struct {
+	XMLName  xml.Name `xml:"WDR"`
+	Mode     WDRMode  `xml:"mode"`
+	WDRLevel int      `xml:"WDRLevel"`
+}
+
+type BLC struct {
+	XMLName xml.Name `xml:"BLC"`
+	Enabled bool     `xml:"enabled"`
+	BLCMode BLCMode  `xml:"BLCMode,omitempty"`
+}
+
+type HLC struct {
+	XMLName  xml.Name `xml:"HLC"`
+	Enabled  bool     `xml:"enabled"`
+	HLCLevel int      `xml:"HLCLevel,omitempty"`
+}
+
+type WhiteBalance struct {
+	XMLName           xml.Name          `xml:"WhiteBalance"`
+	WhiteBalanceStyle WhiteBalanceStyle `xml:"WhiteBalanceStyle"`
+	WhiteBalanceRed   int               `xml:"WhiteBalanceRed,omitempty"`
+	WhiteBalanceBlue  int               `xml:"WhiteBalanceBlue,omitempty"`
+}
+
+type NoiseReduce struct {
+	XMLName     xml.Name        `xml:"NoiseReduce"`
+	Mode        NoiseReduceMode `xml:"mode"`
+	GeneralMode *struct {
+		GeneralLevel int `xml:"generalLevel"`
+	} `xml:"GeneralMode,omitempty"`
+	AdvancedMode *struct {
+		FrameNoiseReduceLevel      int `xml:"FrameNoiseReduceLevel"`
+		InterFrameNoiseReduceLevel int `xml:"InterFrameNoiseReduceLevel"`
+	} `xml:"AdvancedMode,omitempty"`
+}
+
+type ImageChannelCapabilities struct {
+	XMLName     xml.Name  `xml:"ImageChannel"`
+	Version     string    `xml:"version,attr"`
+	ID          string    `xml:"id"`
+	Color       *struct{} `xml:"Color"`
+	IrcutFilter *struct {
+		IrcutFilterType struct {
+			Opt string `xml:"opt,attr"`
+		} `xml:"IrcutFilterType"`
+	} `xml:"IrcutFilter"`
+	Exposure     *struct{} `xml:"Exposure"`
+	WDR          *struct{} `xml:"WDR"`
+	BLC          *struct{} `xml:"BLC"`
+	HLC          *struct{} `xml:"HLC"`
+	WhiteBalance *struct{} `xml:"WhiteBalance"`
+	NoiseReduce  *struct{} `xml:"NoiseReduce"`
+}
+
+func (caps ImageChannelCapabilities) Supports(feature ImageFeature) bool {
+	switch feature {
+	case ImageFeatureColor:
+		return caps.Color != nil
+	case ImageFeatureIrcutFilter:
+		return caps.IrcutFilter != nil
+	case ImageFeatureExposure:
+		return caps.Exposure != nil
+	case ImageFeatureWDR:
+		return caps.WDR != nil
+	case ImageFeatureBLC:
+		return caps.BLC != nil
+	case ImageFeatureHLC:
+		return caps.HLC != nil
+	case ImageFeatureWhiteBalance:
+		return caps.WhiteBalance != nil
+	case ImageFeatureNoiseReduce:
+		return caps.NoiseReduce != nil
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package models
+
+import "encoding/xml"
+
+type IOTriggering string
+
+const (
+	IOTriggeringHigh IOTriggering = "high"
+	IOTriggeringLow  IOTriggering = "low"
+
+	// ISAPI stores the contact type, not the alarm level: the web UI's
+	// NO setting is saved as high and NC as low.
+	IOTriggeringNormallyOpen   = IOTriggeringHigh
+	IOTriggeringNormallyClosed = IOTriggeringLow
+)
+
+type IOOutputState string
+
+const (
+	IOOutputStateHigh  IOOutputState = "high"
+	IOOutputStateLow   IOOutputState = "low"
+	IOOutputStatePulse IOOutputState = "pulse"
+)
+
+type IOInputPortList struct {
+	XMLName     xml.Name      `xml:"IOInputPortList"`
+	Version     string        `xml:"version,attr"`
+	IOInputPort []IOInputPort `xml:"IOInputPort"`
+}
+
+type IOInputPort struct {
+	XMLName    xml.Name     `xml:"IOInputPort"`
+	Version    string       `xml:"version,attr,omitempty"`
+	Xmlns      string       `xml:"xmlns,attr,omitempty"`
+	ID         string       `xml:"id"`
+	Enabled    bool         `xml:"enabled"`
+	Triggering IOTriggering `xml:"triggering"`
+	Name       string       `xml:"name,omitempty"`
+}
+
+type IOOutputPortList struct {
+	XMLName      xml.Name       `xml:"IOOutputPortList"`
+	Version      string         `xml:"version,attr"`
+	IOOutputPort []IOOutputPort `xml:"IOOutputPort"`
+}
+
+type IOOutputPort struct {
+	XMLName      xml.Name `xml:"IOOutputPort"`
+	Version      string   `xml:"version,attr,omitempty"`
+	Xmlns        string   `xml:"xmlns,attr,omitempty"`
+	ID           string   `xml:"id"`
+	Name         string   `xml:"name,omitempty"`
+	PowerOnState struct {
+		DefaultState  IOOutputState `xml:"defaultState"`
+		OutputState   IOOutputState `xml:"outputState"`
+		PulseDuration int           `xml:"pulseDuration,omitempty"`
+	} `xml:"PowerOnState"`
+}
+
+type IOPortStatusList struct {
+	XMLName      xml.Name `xml:"IOPortStatusList"`
+	Version      string   `xml:"version,attr"`
+	IOPortStatus []struct {
+		IOPortID   string `xml:"ioPortID"`
+		IOPortType string `xml:"ioPortType"`
+		IOState    string `xml:"ioState"`
+	} `xml:"IOPortStatus"`
+}
+
+type IOPortData struct {
+	XMLName     xml.Name      `xml:"IOPortData"`
+	OutputState IOOutputState `xml:"outputState"`
+}
@@ -0,0 +1,58 @@
+package models
+
+import "encoding/xml"
+
+type AddressingType string
+
+const (
+	AddressingTypeStatic  AddressingType = "static"
+	AddressingTypeDynamic AddressingType = "dynamic"
+)
+
+type NetworkInterfaceList struct {
+	XMLName          xml.Name           `xml:"NetworkInterfaceList"`
+	Version          string             `xml:"version,attr"`
+	NetworkInterface []NetworkInterface `xml:"NetworkInterface"`
+}
+
+type NetworkInterface struct {
+	XMLName   xml.Name  `xml:"NetworkInterface"`
+	Version   string    `xml:"version,attr,omitempty"`
+	Xmlns     string    `xml:"xmlns,attr,omitempty"`
+	ID        string    `xml:"id"`
+	IPAddress IPAddress `xml:"IPAddress"`
+	Link      *struct {
+		MACAddress      string `xml:"MACAddress"`
+		AutoNegotiation bool   `xml:"autoNegotiation"`
+		Speed           int    `xml:"speed"`
+		Duplex          string `xml:"duplex"`
+		MTU             int    `xml:"MTU"`
+	} `xml:"Link,omitempty"`
+}
+
+type IPAddress struct {
+	XMLName            xml.Name       `xml:"IPAddress"`
+	IPVersion          string         `xml:"ipVersion"`
+	AddressingType     AddressingType `xml:"addressingType"`
+	IPAddress          string         `xml:"ipAddress"`
+	SubnetMask         string         `xml:"subnetMask"`
+	IPv6AddressingType string         `xml:"ipV6AddressingType,omitempty"`
+	DefaultGateway     struct {
+		IPAddress   string `xml:"ipAddress"`
+		IPv6Address string `xml:"ipv6Address,omitempty"`
+	} `xml:"DefaultGateway"`
+	PrimaryDNS struct {
+		IPAddress string `xml:"ipAddress"`
+	} `xml:"PrimaryDNS"`
+	SecondaryDNS struct {
+		IPAddress string `xml:"ipAddress"`
+	} `xml:"SecondaryDNS"`
+	IPv6AddressList *struct {
+		V6Address []struct {
+			ID      string `xml:"id"`
+			Type    string `xml:"type"`
+			Address string `xml:"address"`
+			BitMask int    `xml:"bitMask"`
+		} `xml:"v6Address"`
+	} `xml:"ipv6AddressList,omitempty"`
+}
@@ -0,0 +1,54 @@
+package models
+
+import "encoding/xml"
+
+type OverlayDateStyle string
+
+const (
+	OverlayDateStyleYYYYMMDD OverlayDateStyle = "YYYY-MM-DD"
+	OverlayDateStyleMMDDYYYY OverlayDateStyle = "MM-DD-YYYY"
+	OverlayDateStyleDDMMYYYY OverlayDateStyle = "DD-MM-YYYY"
+)
+
+type OverlayTimeStyle string
+
+const (
+	OverlayTimeStyle12Hour OverlayTimeStyle = "12hour"
+	OverlayTimeStyle24Hour OverlayTimeStyle = "24hour"
+)
+
+type VideoOverlay struct {
+	XMLName              xml.Name              `xml:"VideoOverlay"`
+	Version              string                `xml:"version,attr,omitempty"`
+	Xmlns                string                `xml:"xmlns,attr,omitempty"`
+	NormalizedScreenSize *NormalizedScreenSize `xml:"normalizedScreenSize,omitempty"`
+	Attribute            *struct {
+		Transparent bool `xml:"transparent"`
+		Flashing    bool `xml:"flashing"`
+	} `xml:"attribute,omitempty"`
+	TextOverlayList struct {
+		Size        string        `xml:"size,attr,omitempty"`
+		TextOverlay []TextOverlay `xml:"TextOverlay"`
+	} `xml:"TextOverlayList"`
+	DateTimeOverlay struct {
+		Enabled     bool             `xml:"enabled"`
+		PositionX   int              `xml:"positionX"`
+		PositionY   int              `xml:"positionY"`
+		DateStyle   OverlayDateStyle `xml:"dateStyle"`
+		TimeStyle   OverlayTimeStyle `xml:"timeStyle"`
+		DisplayWeek bool             `xml:"displayWeek"`
+	} `xml:"DateTimeOverlay"`
+	ChannelNameOverlay struct {
+		Enabled   bool `xml:"enabled"`
+		PositionX int  `xml:"positionX"`
+		PositionY int  `xml:"positionY"`
+	} `xml:"channelNameOverlay"`
+}
+
+type TextOverlay struct {
+	ID          string `xml:"id"`
+	Enabled     bool   `xml:"enabled"`
+	PositionX   int    `xml:"positionX"`
+	PositionY   int    `xml:"positionY"`
+	DisplayText string `xml:"displayText"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import "encoding/xml"
+
+type PrivacyMask struct {
+	XMLName               xml.Name              `xml:"PrivacyMask"`
+	Version               string                `xml:"version,attr,omitempty"`
+	Xmlns                 string                `xml:"xmlns,attr,omitempty"`
+	Enabled               bool                  `xml:"enabled"`
+	NormalizedScreenSize  *NormalizedScreenSize `xml:"normalizedScreenSize,omitempty"`
+	PrivacyMaskRegionList struct {
+		Size              int                 `xml:"size,attr,omitempty"`
+		PrivacyMaskRegion []PrivacyMaskRegion `xml:"PrivacyMaskRegion"`
+	} `xml:"PrivacyMaskRegionList"`
+}
+
+type PrivacyMaskRegion struct {
+	ID                    string                `xml:"id"`
+	Enabled               bool                  `xml:"enabled"`
+	RegionCoordinatesList RegionCoordinatesList `xml:"RegionCoordinatesList"`
+}
@@ -0,0 +1,83 @@
+package models
+
+import "encoding/xml"
+
+type PTZData struct {
+	XMLName   xml.Name `xml:"PTZData"`
+	Pan       int      `xml:"pan"`
+	Tilt      int      `xml:"tilt"`
+	Zoom      int      `xml:"zoom"`
+	Momentary *struct {
+		Duration int `xml:"duration"`
+	} `xml:"Momentary,omitempty"`
+}
+
+type PTZAbsoluteData struct {
+	XMLName      xml.Name    `xml:"PTZData"`
+	AbsoluteHigh PTZPosition `xml:"AbsoluteHigh"`
+}
+
+type PTZRelativeData struct {
+	XMLName  xml.Name `xml:"PTZData"`
+	Relative struct {
+		PositionX    int `xml:"positionX"`
+		PositionY    int `xml:"positionY"`
+		RelativeZoom int `xml:"relativeZoom"`
+	} `xml:"Relative"`
+}
+
+type PTZPosition struct {
+	Elevation    int `xml:"elevation"`
+	Azimuth      int `xml:"azimuth"`
+	AbsoluteZoom int `xml:"absoluteZoom"`
+}
+
+type PTZStatus struct {
+	XMLName      xml.Name    `xml:"PTZStatus"`
+	Version      string      `xml:"version,attr"`
+	AbsoluteHigh PTZPosition `xml:"AbsoluteHigh"`
+}
+
+type PTZPresetList struct {
+	XMLName   xml.Name    `xml:"PTZPresetList"`
+	Version   string      `xml:"version,attr"`
+	PTZPreset []PTZPreset `xml:"PTZPreset"`
+}
+
+type PTZPreset struct {
+	XMLName    xml.Name `xml:"PTZPreset"`
+	Version    string   `xml:"version,attr,omitempty"`
+	Xmlns      string   `xml:"xmlns,attr,omitempty"`
+	ID         string   `xml:"id"`
+	PresetName string   `xml:"presetName"`
+	Enabled    bool     `xml:"enabled,omitempty"`
+}
+
+type PTZPatrolList struct {
+	XMLName   xml.Name    `xml:"PTZPatrolList"`
+	Version   string      `xml:"version,attr"`
+	PTZPatrol []PTZPatrol `xml:"PTZPatrol"`
+}
+
+type PTZPatrol struct {
+	XMLName            xml.Name `xml:"PTZPatrol"`
+	Version            string   `xml:"version,attr,omitempty"`
+	Xmlns              string   `xml:"xmlns,attr,omitempty"`
+	ID                 string   `xml:"id"`
+	PatrolName         string   `xml:"patrolName"`
+	PatrolSequenceList struct {
+		PatrolSequence []PatrolSequence `xml:"PatrolSequence"`
+	} `xml:"PatrolSequenceList"`
+}
+
+type PatrolSequence struct {
+	PresetID int `xml:"presetID"`
+	Delay    int `xml:"delay"`
+	Speed    int `xml:"speed,omitempty"`
+}
+
+type PTZAction struct {
+	PTZChannelID string `xml:"ptzChannelID"`
+	ActionName   string `xml:"actionName"`
+	ActionNum    int    `xml:"actionNum"`
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type RecordingMode string
+
+const (
+	RecordingModeContinuous     RecordingMode = "CMR"
+	RecordingModeMotion         RecordingMode = "MOTION"
+	RecordingModeAlarm          RecordingMode = "ALARM"
+	RecordingModeMotionOrAlarm  RecordingMode = "EDR"
+	RecordingModeMotionAndAlarm RecordingMode = "ALARMANDMOTION"
+)
+
+type Track struct {
+	XMLName              xml.Name `xml:"Track"`
+	Version              string   `xml:"version,attr,omitempty"`
+	Xmlns                string   `xml:"xmlns,attr,omitempty"`
+	ID                   int      `xml:"id"`
+	Channel              int      `xml:"Channel"`
+	Enable               bool     `xml:"Enable"`
+	Description          string   `xml:"Description,omitempty"`
+	TrackGUID            string   `xml:"TrackGUID,omitempty"`
+	Duration             string   `xml:"Duration,omitempty"`
+	DefaultRecordingMode string   `xml:"DefaultRecordingMode,omitempty"`
+	LoopEnable           bool     `xml:"LoopEnable"`
+	ContentType          string   `xml:"ContentType,omitempty"`
+	CodecType            string   `xml:"CodecType,omitempty"`
+	SrcDescriptor        *struct {
+		SrcGUID       string `xml:"SrcGUID"`
+		SrcChannel    int    `xml:"SrcChannel"`
+		StreamHint    string `xml:"StreamHint"`
+		SrcDriver     string `xml:"SrcDriver"`
+		SrcType       string `xml:"SrcType"`
+		SrcUrl        string `xml:"SrcUrl"`
+		SrcUrlMethods string `xml:"SrcUrlMethods"`
+		SrcLogin      string `xml:"SrcLogin"`
+	} `xml:"SrcDescriptor"`
+	TrackSchedule struct {
+		ScheduleBlockList struct {
+			ScheduleBlock []ScheduleBlock `xml:"ScheduleBlock"`
+		} `xml:"ScheduleBlockList"`
+	} `xml:"TrackSchedule"`
+	CustomExtensionList struct {
+		CustomExtension struct {
+			CustomExtensionName   string       `xml:"CustomExtensionName,omitempty"`
+			EnableSchedule        bool         `xml:"enableSchedule"`
+			SaveAudio             bool         `xml:"SaveAudio"`
+			PreRecordTimeSeconds  int          `xml:"PreRecordTimeSeconds"`
+			PostRecordTimeSeconds int          `xml:"PostRecordTimeSeconds"`
+			Other                 []RawElement `xml:",any"`
+		} `xml:"CustomExtension"`
+	} `xml:"CustomExtensionList"`
+	Other []RawElement `xml:",any"`
+}
+
+type RawElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	InnerXML string     `xml:",innerxml"`
+}
+
+type ScheduleBlock struct {
+	ScheduleBlockGUID string           `xml:"ScheduleBlockGUID"`
+	ScheduleBlockType string           `xml:"ScheduleBlockType"`
+	ScheduleAction    []ScheduleAction `xml:"ScheduleAction"`
+}
+
+type ScheduleAction struct {
+	ID                      int            `xml:"id"`
+	ScheduleActionStartTime ScheduleMoment `xml:"ScheduleActionStartTime"`
+	ScheduleActionEndTime   ScheduleMoment `xml:"ScheduleActionEndTime"`
+	ScheduleDSTEnable       bool           `xml:"ScheduleDSTEnable"`
+	Description             string         `xml:"Description,omitempty"`
+	Actions                 struct {
+		Record              bool          `xml:"Record"`
+		Log                 bool          `xml:"Log"`
+		SaveImg             bool          `xml:"SaveImg"`
+		ActionRecordingMode RecordingMode `xml:"ActionRecordingMode"`
+	} `xml:"Actions"`
+}
+
+type ScheduleMoment struct {
+	DayOfWeek string `xml:"DayOfWeek"`
+	TimeOfDay string `xml:"TimeOfDay"`
+}
+
+type RecordSchedule struct {
+	Enabled       bool
+	PreRecord     time.Duration
+	PostRecord    time.Duration
+	RetentionDays int
+	Blocks        []RecordScheduleBlock
+}
+
+type RecordScheduleBlock struct {
+	Mode      RecordingMode
+	TimeBlock TimeBlock
+}
+
+type TrackDailyParam struct {
+	XMLName     xml.Name `xml:"trackDailyParam"`
+	Year        int      `xml:"year"`
+	MonthOfYear int      `xml:"monthOfYear"`
+}
+
+type TrackDailyDistribution struct {
+	XMLName xml.Name `xml:"trackDailyDistribution"`
+	Version string   `xml:"version,attr"`
+	DayList struct {
+		Day []struct {
+			ID         string `xml:"id"`
+			DayOfMonth int    `xml:"dayOfMonth"`
+			Record     bool   `xml:"record"`
+			RecordType string `xml:"recordType"`
+		} `xml:"day"`
+	} `xml:"dayList"`
+}
+
+type TimeInterval struct {
+	Start time.Time
+	End   time.Time
+}
+
+type EventMarker struct {
+	TimeInterval
+	RecordType RecordType
+}
+
+type RecordingTimeline struct {
+	Channel  int
+	Start    time.Time
+	End      time.Time
+	Recorded []TimeInterval
+	Gaps     []TimeInterval
+	Events   []EventMarker
+}
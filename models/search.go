@@ -0,0 +1,82 @@
+package models
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type RecordType string
+
+const (
+	RecordTypeAll        RecordType = "AllEvent"
+	RecordTypeContinuous RecordType = "CMR"
+	RecordTypeMotion     RecordType = "VideoMotion"
+	RecordTypeAlarm      RecordType = "AlarmInput"
+)
+
+type CMSearchDescription struct {
+	XMLName   xml.Name `xml:"CMSearchDescription"`
+	Version   string   `xml:"version,attr,omitempty"`
+	Xmlns     string   `xml:"xmlns,attr,omitempty"`
+	SearchID  string   `xml:"searchID"`
+	TrackList struct {
+		TrackID []int `xml:"trackID"`
+	} `xml:"trackList"`
+	TimeSpanList struct {
+		TimeSpan []TimeSpan `xml:"timeSpan"`
+	} `xml:"timeSpanList"`
+	MaxResults int `xml:"maxResults"`
+	// ISAPI spells the paging position element this way.
+	SearchResultPosition int `xml:"searchResultPostion"`
+	MetadataList         *struct {
+		MetadataDescriptor []string `xml:"metadataDescriptor"`
+	} `xml:"metadataList,omitempty"`
+}
+
+type TimeSpan struct {
+	StartTime string `xml:"startTime"`
+	EndTime   string `xml:"endTime"`
+}
+
+type CMSearchResult struct {
+	XMLName            xml.Name `xml:"CMSearchResult"`
+	Version            string   `xml:"version,attr"`
+	SearchID           string   `xml:"searchID"`
+	ResponseStatus     bool     `xml:"responseStatus"`
+	ResponseStatusStrg string   `xml:"responseStatusStrg"`
+	NumOfMatches       int      `xml:"numOfMatches"`
+	MatchList          struct {
+		SearchMatchItem []SearchMatchItem `xml:"searchMatchItem"`
+	} `xml:"matchList"`
+}
+
+type SearchMatchItem struct {
+	SourceID               string   `xml:"sourceID"`
+	TrackID                int      `xml:"trackID"`
+	TimeSpan               TimeSpan `xml:"timeSpan"`
+	MediaSegmentDescriptor struct {
+		ContentType string `xml:"contentType"`
+		CodecType   string `xml:"codecType"`
+		PlaybackURI string `xml:"playbackURI"`
+	} `xml:"mediaSegmentDescriptor"`
+	MetadataMatches struct {
+		MetadataDescriptor string `xml:"metadataDescriptor"`
+	} `xml:"metadataMatches"`
+}
+
+type RecordingMatch struct {
+	Channel     int
+	TrackID     int
+	Start       time.Time
+	End         time.Time
+	RecordType  RecordType
+	ContentType string
+	CodecType   string
+	PlaybackURI string
+}
+
+type DownloadRequest struct {
+	XMLName     xml.Name `xml:"downloadRequest"`
+	Version     string   `xml:"version,attr,omitempty"`
+	PlaybackURI string   `xml:"playbackURI"`
+}
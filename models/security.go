@@ -0,0 +1,15 @@
+package models
+
+import "encoding/xml"
+
+type AdminAccessProtocolList struct {
+	XMLName             xml.Name `xml:"AdminAccessProtocolList"`
+	Version             string   `xml:"version,attr,omitempty"`
+	Xmlns               string   `xml:"xmlns,attr,omitempty"`
+	AdminAccessProtocol []struct {
+		ID       string `xml:"id"`
+		Enabled  bool   `xml:"enabled"`
+		Protocol string `xml:"protocol"`
+		PortNo   int    `xml:"portNo"`
+	} `xml:"AdminAccessProtocol"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import "encoding/xml"
+
+type NormalizedScreenSize struct {
+	NormalizedScreenWidth  int `xml:"normalizedScreenWidth"`
+	NormalizedScreenHeight int `xml:"normalizedScreenHeight"`
+}
+
+type RegionCoordinates struct {
+	PositionX int `xml:"positionX"`
+	PositionY int `xml:"positionY"`
+}
+
+type RegionCoordinatesList struct {
+	RegionCoordinates []RegionCoordinates `xml:"RegionCoordinates"`
+}
+
+type RegionEntrance struct {
+	XMLName                  xml.Name              `xml:"RegionEntrance"`
+	Version                  string                `xml:"version,attr,omitempty"`
+	Xmlns                    string                `xml:"xmlns,attr,omitempty"`
+	ID                       string                `xml:"id"`
+	Enabled                  bool                  `xml:"enabled"`
+	NormalizedScreenSize     *NormalizedScreenSize `xml:"normalizedScreenSize,omitempty"`
+	RegionEntranceRegionList struct {
+		RegionEntranceRegion []SmartRegion `xml:"RegionEntranceRegion"`
+	} `xml:"RegionEntranceRegionList"`
+}
+
+type RegionExiting struct {
+	XMLName                 xml.Name              `xml:"RegionExiting"`
+	Version                 string                `xml:"version,attr,omitempty"`
+	Xmlns                   string                `xml:"xmlns,attr,omitempty"`
+	ID                      string                `xml:"id"`
+	Enabled                 bool                  `xml:"enabled"`
+	NormalizedScreenSize    *NormalizedScreenSize `xml:"normalizedScreenSize,omitempty"`
+	RegionExitingRegionList struct {
+		RegionExitingRegion []SmartRegion `xml:"RegionExitingRegion"`
+	} `xml:"RegionExitingRegionList"`
+}
+
+type SmartRegion struct {
+	ID                    string                `xml:"id"`
+	SensitivityLevel      int                   `xml:"sensitivityLevel"`
+	RegionCoordinatesList RegionCoordinatesList `xml:"RegionCoordinatesList"`
+	DetectionTarget       string                `xml:"detectionTarget,omitempty"`
+}
@@ -0,0 +1,94 @@
+package models
+
+import "encoding/xml"
+
+type HDDStatus string
+
+const (
+	HDDStatusOK          HDDStatus = "ok"
+	HDDStatusUnformatted HDDStatus = "unformatted"
+	HDDStatusFormatting  HDDStatus = "formating"
+	HDDStatusError       HDDStatus = "error"
+	HDDStatusIdle        HDDStatus = "idle"
+	HDDStatusMismatch    HDDStatus = "mismatch"
+	HDDStatusOffline     HDDStatus = "offline"
+	HDDStatusSmartFailed HDDStatus = "smartFailed"
+	HDDStatusRepairing   HDDStatus = "reparing"
+	HDDStatusNotExist    HDDStatus = "notexist"
+)
+
+type HDDProperty string
+
+const (
+	HDDPropertyReadWrite HDDProperty = "RW"
+	HDDPropertyReadOnly  HDDProperty = "RO"
+	HDDPropertyRedundant HDDProperty = "Redund"
+)
+
+type Storage struct {
+	XMLName xml.Name `xml:"storage"`
+	Version string   `xml:"version,attr"`
+	HDDList struct {
+		HDD []HDD `xml:"hdd"`
+	} `xml:"hddList"`
+	NASList struct {
+		NAS []struct {
+			ID         string      `xml:"id"`
+			IPAddress  string      `xml:"ipAddress"`
+			Path       string      `xml:"path"`
+			Status     HDDStatus   `xml:"status"`
+			Capacity   int64       `xml:"capacity"`
+			FreeSpace  int64       `xml:"freeSpace"`
+			Property   HDDProperty `xml:"property"`
+			MountTypes string      `xml:"mountTypes"`
+		} `xml:"nas"`
+	} `xml:"nasList"`
+}
+
+type HDD struct {
+	ID        string           `xml:"id"`
+	HDDName   string           `xml:"hddName"`
+	HDDPath   string           `xml:"hddPath"`
+	HDDType   string           `xml:"hddType"`
+	Status    HDDStatus        `xml:"status"`
+	Capacity  int64            `xml:"capacity"`
+	FreeSpace int64            `xml:"freeSpace"`
+	Property  HDDProperty      `xml:"property"`
+	SMART     *SMARTTestStatus `xml:"-"`
+}
+
+type SMARTTestStatus struct {
+	XMLName              xml.Name `xml:"SMARTTestStatus"`
+	Version              string   `xml:"version,attr"`
+	Temperature          int      `xml:"temprature"`
+	PowerOnDay           int      `xml:"powerOnDay"`
+	SelfEvaluatingStatus string   `xml:"selfEvaluaingStatus"`
+	AllEvaluatingStatus  string   `xml:"allEvaluaingStatus"`
+}
+
+type FormatStatus struct {
+	XMLName   xml.Name `xml:"formatStatus"`
+	Version   string   `xml:"version,attr"`
+	Formating bool     `xml:"formating"`
+	Percent   int      `xml:"percent"`
+}
+
+type DiskQuotaList struct {
+	XMLName   xml.Name    `xml:"diskQuotaList"`
+	Version   string      `xml:"version,attr"`
+	DiskQuota []DiskQuota `xml:"diskQuota"`
+}
+
+type DiskQuota struct {
+	XMLName           xml.Name `xml:"diskQuota"`
+	Version           string   `xml:"version,attr,omitempty"`
+	Xmlns             string   `xml:"xmlns,attr,omitempty"`
+	ID                string   `xml:"id"`
+	Type              string   `xml:"type"`
+	VideoQuotaRatio   int      `xml:"videoQuotaRatio"`
+	PictureQuotaRatio int      `xml:"pictureQuotaRatio"`
+	TotalVideoVolume  int64    `xml:"totalVideoVolume,omitempty"`
+	FreeVideoQuota    int64    `xml:"freeVideoQuota,omitempty"`
+	TotalPictureVol   int64    `xml:"totalPictureVolume,omitempty"`
+	FreePictureQuota  int64    `xml:"freePictureQuota,omitempty"`
+}
@@ -0,0 +1,88 @@
+package models
+
+import "encoding/xml"
+
+type VideoCodec string
+
+const (
+	VideoCodecH264     VideoCodec = "H.264"
+	VideoCodecH264Plus VideoCodec = "H.264+"
+	VideoCodecH265     VideoCodec = "H.265"
+	VideoCodecH265Plus VideoCodec = "H.265+"
+	VideoCodecMJPEG    VideoCodec = "MJPEG"
+)
+
+type BitrateControl string
+
+const (
+	BitrateControlCBR BitrateControl = "CBR"
+	BitrateControlVBR BitrateControl = "VBR"
+)
+
+type StreamingChannelList struct {
+	XMLName          xml.Name           `xml:"StreamingChannelList"`
+	Version          string             `xml:"version,attr"`
+	StreamingChannel []StreamingChannel `xml:"StreamingChannel"`
+}
+
+type StreamingChannel struct {
+	XMLName     xml.Name `xml:"StreamingChannel"`
+	Version     string   `xml:"version,attr,omitempty"`
+	Xmlns       string   `xml:"xmlns,attr,omitempty"`
+	ID          string   `xml:"id"`
+	ChannelName string   `xml:"channelName"`
+	Enabled     bool     `xml:"enabled"`
+	Video       struct {
+		Enabled                 bool           `xml:"enabled"`
+		VideoInputChannelID     string         `xml:"videoInputChannelID"`
+		VideoCodecType          VideoCodec     `xml:"videoCodecType"`
+		VideoScanType           string         `xml:"videoScanType,omitempty"`
+		VideoResolutionWidth    int            `xml:"videoResolutionWidth"`
+		VideoResolutionHeight   int            `xml:"videoResolutionHeight"`
+		VideoQualityControlType BitrateControl `xml:"videoQualityControlType"`
+		ConstantBitRate         int            `xml:"constantBitRate,omitempty"`
+		FixedQuality            int            `xml:"fixedQuality,omitempty"`
+		VbrUpperCap             int            `xml:"vbrUpperCap,omitempty"`
+		MaxFrameRate            int            `xml:"maxFrameRate"`
+		GovLength               int            `xml:"GovLength"`
+		H264Profile             string         `xml:"H264Profile,omitempty"`
+		H265Profile             string         `xml:"H265Profile,omitempty"`
+		SmartCodec              *struct {
+			Enabled bool `xml:"enabled"`
+		} `xml:"SmartCodec,omitempty"`
+	} `xml:"Video"`
+	Audio *struct {
+		Enabled              bool   `xml:"enabled"`
+		AudioInputChannelID  string `xml:"audioInputChannelID,omitempty"`
+		AudioCompressionType string `xml:"audioCompressionType,omitempty"`
+	} `xml:"Audio,omitempty"`
+}
+
+func (s StreamingChannel) Codec() VideoCodec {
+	if s.Video.SmartCodec == nil || !s.Video.SmartCodec.Enabled {
+		return s.Video.VideoCodecType
+	}
+	switch s.Video.VideoCodecType {
+	case VideoCodecH264:
+		return VideoCodecH264Plus
+	case VideoCodecH265:
+		return VideoCodecH265Plus
+	}
+	return s.Video.VideoCodecType
+}
+
+func (s *StreamingChannel) SetCodec(codec VideoCodec) {
+	smartCodec := false
+	switch codec {
+	case VideoCodecH264Plus:
+		codec, smartCodec = VideoCodecH264, true
+	case VideoCodecH265Plus:
+		codec, smartCodec = VideoCodecH265, true
+	}
+	s.Video.VideoCodecType = codec
+	if s.Video.SmartCodec != nil || smartCodec {
+		s.Video.SmartCodec = &struct {
+			Enabled bool `xml:"enabled"`
+		}{Enabled: smartCodec}
+	}
+}
@@ -0,0 +1,23 @@
+package models
+
+import "encoding/xml"
+
+type DeviceInfo struct {
+	XMLName              xml.Name `xml:"DeviceInfo"`
+	Version              string   `xml:"version,attr"`
+	DeviceName           string   `xml:"deviceName"`
+	DeviceID             string   `xml:"deviceID"`
+	Model                string   `xml:"model"`
+	SerialNumber         string   `xml:"serialNumber"`
+	MACAddress           string   `xml:"macAddress"`
+	FirmwareVersion      string   `xml:"firmwareVersion"`
+	FirmwareReleasedDate string   `xml:"firmwareReleasedDate"`
+	DeviceType           string   `xml:"deviceType"`
+}
+
+type UpgradeStatus struct {
+	XMLName   xml.Name `xml:"upgradeStatus"`
+	Version   string   `xml:"version,attr"`
+	Upgrading bool     `xml:"upgrading"`
+	Percent   int      `xml:"percent"`
+}
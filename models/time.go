@@ -0,0 +1,53 @@
+package models
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type TimeMode string
+
+const (
+	TimeModeNTP    TimeMode = "NTP"
+	TimeModeManual TimeMode = "manual"
+)
+
+type Time struct {
+	XMLName   xml.Name `xml:"Time"`
+	Version   string   `xml:"version,attr,omitempty"`
+	Xmlns     string   `xml:"xmlns,attr,omitempty"`
+	TimeMode  TimeMode `xml:"timeMode"`
+	LocalTime string   `xml:"localTime"`
+	TimeZone  string   `xml:"timeZone"`
+}
+
+type NTPServerList struct {
+	XMLName   xml.Name    `xml:"NTPServerList"`
+	Version   string      `xml:"version,attr,omitempty"`
+	Xmlns     string      `xml:"xmlns,attr,omitempty"`
+	NTPServer []NTPServer `xml:"NTPServer"`
+}
+
+type NTPServer struct {
+	XMLName              xml.Name `xml:"NTPServer"`
+	ID                   string   `xml:"id"`
+	AddressingFormatType string   `xml:"addressingFormatType"`
+	HostName             string   `xml:"hostName,omitempty"`
+	IPAddress            string   `xml:"ipAddress,omitempty"`
+	IPv6Address          string   `xml:"ipv6Address,omitempty"`
+	PortNo               int      `xml:"portNo"`
+	SynchronizeInterval  int      `xml:"synchronizeInterval"`
+}
+
+type TimeZone struct {
+	Name   string
+	Offset time.Duration
+	DST    *DSTRule
+}
+
+type DSTRule struct {
+	Name  string
+	Bias  time.Duration
+	Start string
+	End   string
+}
@@ -0,0 +1,73 @@
+package annkesdk
+
+import (
+	"context"
+	"net"
+	"net/http/cookiejar"
+	"strings"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+type SafeApplyOptions struct {
+	Host string
+	OnlineOptions
+}
+
+func (c Connector) GetAdminAccesses() (models.AdminAccessProtocolList, error) {
+	adminAccesses := models.AdminAccessProtocolList{}
+	err := c.makeGetRequest(adminAccessesPath, &adminAccesses)
+	return adminAccesses, err
+}
+
+func (c Connector) UpdateAdminAccesses(adminAccesses models.AdminAccessProtocolList) error {
+	return c.makeUpdateRequest(adminAccessesPath, adminAccesses)
+}
+
+func (c Connector) GetNetworkInterfaces() (models.NetworkInterfaceList, error) {
+	interfaces := models.NetworkInterfaceList{}
+	err := c.makeGetRequest(networkInterfaces, &interfaces)
+	return interfaces, err
+}
+
+func (c Connector) GetNetworkInterface(id int) (models.NetworkInterface, error) {
+	networkInterface := models.NetworkInterface{}
+	err := c.makeGetRequest(getNetworkInterfacePath(id), &networkInterface)
+	return networkInterface, err
+}
+
+func (c Connector) UpdateNetworkInterface(id int, networkInterface models.NetworkInterface) error {
+	return c.makeUpdateRequest(getNetworkInterfacePath(id), networkInterface)
+}
+
+func (c Connector) SafeApplyNetworkInterface(ctx context.Context, id int, networkInterface models.NetworkInterface, opts SafeApplyOptions) (*Connector, error) {
+	host := opts.Host
+	if host == "" {
+		if networkInterface.IPAddress.AddressingType != models.AddressingTypeStatic || networkInterface.IPAddress.IPAddress == "" {
+			return nil, NewAnnkeValidationError("Host", "the new address is unknown for DHCP interfaces, set SafeApplyOptions.Host")
+		}
+		host = c.hostWithPort(networkInterface.IPAddress.IPAddress)
+	}
+	if err := c.makeRequest(ctx, "PUT", getNetworkInterfacePath(id), networkInterface, nil); err != nil {
+		return nil, err
+	}
+
+	moved := c
+	moved.Host = host
+	jar, _ := cookiejar.New(nil)
+	moved.client.Jar = jar
+	if err := moved.WaitOnline(ctx, opts.OnlineOptions); err != nil {
+		return nil, err
+	}
+	return &moved, nil
+}
+
+func (c Connector) hostWithPort(address string) string {
+	if _, port, err := net.SplitHostPort(c.Host); err == nil {
+		return net.JoinHostPort(address, port)
+	}
+	if strings.Contains(address, ":") {
+		return "[" + address + "]"
+	}
+	return address
+}
@@ -0,0 +1,46 @@
+package annkesdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const mockLoginCapabilities = "<?xml version=\"1.0\" encoding=\"UTF-8\" ?><SessionLoginCap version=\"1.0\" xmlns=\"http://www.std-cgi.com/ver20/XMLSchema\"><sessionID>123</sessionID><challenge>123</challenge><iterations>100</iterations><isIrreversible>true</isIrreversible><salt>123</salt><isSessionIDValidLongTerm opt=\"true,false\">false</isSessionIDValidLongTerm><sessionIDVersion>2</sessionIDVersion></SessionLoginCap>"
+
+func TestConnector_SafeApplyNetworkInterface(t *testing.T) {
+	var updated string
+	oldDevice := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		updated = r.Method + " " + r.URL.Path
+	}))
+	defer oldDevice.Close()
+	newDevice := httptest.NewServer(testLoginHanlerHelper(mockLoginCapabilities, "", http.StatusOK, http.StatusOK))
+	defer newDevice.Close()
+
+	networkInterface := models.NetworkInterface{ID: "1"}
+	networkInterface.IPAddress.AddressingType = models.AddressingTypeDynamic
+
+	c := Connector{Host: oldDevice.URL[7:], User: "mock-user"}
+	_, err := c.SafeApplyNetworkInterface(context.Background(), 1, networkInterface, SafeApplyOptions{})
+	assert.EqualError(t, err, "invalid parameter Host: the new address is unknown for DHCP interfaces, set SafeApplyOptions.Host")
+
+	moved, err := c.SafeApplyNetworkInterface(context.Background(), 1, networkInterface, SafeApplyOptions{
+		Host:          newDevice.URL[7:],
+		OnlineOptions: OnlineOptions{Timeout: time.Second, Interval: 10 * time.Millisecond},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "PUT /ISAPI/System/Network/interfaces/1", updated)
+	assert.Equal(t, newDevice.URL[7:], moved.Host)
+	assert.Equal(t, oldDevice.URL[7:], c.Host)
+}
+
+func TestConnector_hostWithPort(t *testing.T) {
+	assert.Equal(t, "10.0.0.5:8080", Connector{Host: "192.168.1.10:8080"}.hostWithPort("10.0.0.5"))
+	assert.Equal(t, "10.0.0.5", Connector{Host: "192.168.1.10"}.hostWithPort("10.0.0.5"))
+	assert.Equal(t, "[fe80::5]", Connector{Host: "192.168.1.10"}.hostWithPort("fe80::5"))
+}
@@ -0,0 +1,50 @@
+package annkesdk
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+func (c Connector) GetOverlays(channel int) (models.VideoOverlay, error) {
+	overlay := models.VideoOverlay{}
+	err := c.makeGetRequest(getOverlaysPath(channel), &overlay)
+	return overlay, err
+}
+
+func (c Connector) UpdateOverlays(channel int, overlay models.VideoOverlay) error {
+	return c.makeUpdateRequest(getOverlaysPath(channel), overlay)
+}
+
+func (c Connector) SyncOverlayChannelNames() error {
+	channels, err := c.GetChannels()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, channel := range channels.VideoInputChannel {
+		id, err := strconv.Atoi(channel.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Error parsing channel id %s %w", channel.ID, err))
+			continue
+		}
+		if err := c.syncOverlayChannelName(id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c Connector) syncOverlayChannelName(channel int) error {
+	overlay, err := c.GetOverlays(channel)
+	if err != nil {
+		return err
+	}
+	if overlay.ChannelNameOverlay.Enabled {
+		return nil
+	}
+	overlay.ChannelNameOverlay.Enabled = true
+	return c.UpdateOverlays(channel, overlay)
+}
@@ -0,0 +1,37 @@
+package annkesdk
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_SyncOverlayChannelNames(t *testing.T) {
+	overlays := map[string]string{
+		"/ISAPI/System/Video/inputs/channels/1/overlays": "<VideoOverlay><DateTimeOverlay><enabled>true</enabled><positionX>0</positionX><positionY>0</positionY><dateStyle>YYYY-MM-DD</dateStyle><timeStyle>24hour</timeStyle><displayWeek>false</displayWeek></DateTimeOverlay><channelNameOverlay><enabled>false</enabled><positionX>512</positionX><positionY>64</positionY></channelNameOverlay></VideoOverlay>",
+		"/ISAPI/System/Video/inputs/channels/2/overlays": "<VideoOverlay><channelNameOverlay><enabled>true</enabled><positionX>512</positionX><positionY>64</positionY></channelNameOverlay></VideoOverlay>",
+	}
+	updates := map[string]string{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT":
+			body, _ := io.ReadAll(r.Body)
+			updates[r.URL.Path] = string(body)
+		case r.URL.Path == inputChannelsPath:
+			fmt.Fprint(w, "<VideoInputChannelList><VideoInputChannel><id>1</id><name>Gate</name></VideoInputChannel><VideoInputChannel><id>2</id><name>Yard</name></VideoInputChannel></VideoInputChannelList>")
+		default:
+			fmt.Fprint(w, overlays[r.URL.Path])
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	assert.Nil(t, c.SyncOverlayChannelNames())
+	assert.Equal(t, map[string]string{
+		"/ISAPI/System/Video/inputs/channels/1/overlays": "<VideoOverlay><TextOverlayList></TextOverlayList><DateTimeOverlay><enabled>true</enabled><positionX>0</positionX><positionY>0</positionY><dateStyle>YYYY-MM-DD</dateStyle><timeStyle>24hour</timeStyle><displayWeek>false</displayWeek></DateTimeOverlay><channelNameOverlay><enabled>true</enabled><positionX>512</positionX><positionY>64</positionY></channelNameOverlay></VideoOverlay>",
+	}, updates)
+}
@@ -0,0 +1,111 @@
+package annkesdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+type PictureQuery struct {
+	Channel     int
+	Start       time.Time
+	End         time.Time
+	RecordTypes []models.RecordType
+	PageSize    int
+}
+
+type pictureDownload struct {
+	match models.RecordingMatch
+	path  string
+}
+
+func (c Connector) SearchPictures(ctx context.Context, query PictureQuery) (*RecordingIterator, error) {
+	if query.Channel <= 0 {
+		return nil, NewAnnkeValidationError("Channel", "must be a positive channel id")
+	}
+	return c.newSearch(ctx, []int{StreamID(query.Channel, pictureTrack)}, query.Start, query.End, query.RecordTypes, query.PageSize)
+}
+
+func (c Connector) DownloadPictures(ctx context.Context, query PictureQuery, dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	it, err := c.SearchPictures(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		downloaded []string
+		errs       []error
+		limiter    = c.requestLimiter()
+	)
+	used := map[string]int{}
+	for it.Next() {
+		match := it.Item()
+		download := pictureDownload{match: match, path: filepath.Join(dir, pictureFileName(match, used))}
+		release, err := limiter.acquire(ctx, c.concurrency())
+		if err != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer release()
+			err := c.downloadPicture(ctx, download)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			downloaded = append(downloaded, download.path)
+		}()
+	}
+	wg.Wait()
+
+	if err := it.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return downloaded, errors.Join(errs...)
+}
+
+func (c Connector) downloadPicture(ctx context.Context, download pictureDownload) error {
+	file, err := os.Create(download.path)
+	if err != nil {
+		return err
+	}
+	_, err = c.DownloadRecording(ctx, download.match.PlaybackURI, file, DownloadOptions{})
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(download.path)
+		return fmt.Errorf("Error downloading picture %s %w", download.match.PlaybackURI, err)
+	}
+	return nil
+}
+
+func pictureFileName(match models.RecordingMatch, used map[string]int) string {
+	recordType := match.RecordType
+	if recordType == "" {
+		recordType = "unknown"
+	}
+	name := fmt.Sprintf("ch%02d_%s_%s", match.Channel, match.Start.UTC().Format(playbackTimeFormat), recordType)
+	used[name]++
+	if count := used[name]; count > 1 {
+		name = fmt.Sprintf("%s_%d", name, count)
+	}
+	return name + ".jpg"
+}
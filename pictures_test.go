@@ -0,0 +1,117 @@
+package annkesdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockPictureServer(t *testing.T, download func(w http.ResponseWriter, body string)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case searchPath:
+			items := ""
+			for hour := 0; hour < 4; hour++ {
+				start := fmt.Sprintf("2024-03-01T%02d:00:00Z", hour)
+				items += mockSearchItem(start, start)
+			}
+			fmt.Fprintf(w, "<CMSearchResult><responseStatusStrg>OK</responseStatusStrg><numOfMatches>4</numOfMatches><matchList>%s</matchList></CMSearchResult>", items)
+		case downloadPath:
+			body, _ := io.ReadAll(r.Body)
+			download(w, string(body))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+}
+
+func mockPictureQuery() PictureQuery {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	return PictureQuery{Channel: 2, Start: start, End: start.Add(24 * time.Hour)}
+}
+
+func TestConnector_DownloadPictures(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	ts := mockPictureServer(t, func(w http.ResponseWriter, body string) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		if strings.Contains(body, "starttime=2024-03-01T03:00:00Z") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, "mock-jpeg")
+	})
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:], MaxConcurrency: 2}
+	dirs := []string{t.TempDir(), t.TempDir()}
+	results := make([][]string, len(dirs))
+	errs := make([]error, len(dirs))
+	var wg sync.WaitGroup
+	for i, dir := range dirs {
+		wg.Add(1)
+		go func(i int, dir string) {
+			defer wg.Done()
+			results[i], errs[i] = c.DownloadPictures(context.Background(), mockPictureQuery(), dir)
+		}(i, dir)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, maxInFlight)
+	for i, dir := range dirs {
+		assert.ErrorContains(t, errs[i], "Error downloading picture rtsp://mock/Streaming/tracks/201/?starttime=2024-03-01T03:00:00Z")
+		assert.Len(t, results[i], 3)
+		content, err := os.ReadFile(filepath.Join(dir, "ch02_20240301T000000Z_VideoMotion.jpg"))
+		assert.Nil(t, err)
+		assert.Equal(t, "mock-jpeg", string(content))
+		_, err = os.Stat(filepath.Join(dir, "ch02_20240301T030000Z_VideoMotion.jpg"))
+		assert.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestConnector_DownloadPicturesCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ts := mockPictureServer(t, func(w http.ResponseWriter, body string) {
+		w.(http.Flusher).Flush()
+		cancel()
+		time.Sleep(20 * time.Millisecond)
+	})
+	defer ts.Close()
+
+	dir := t.TempDir()
+	c := Connector{Host: ts.URL[7:], MaxConcurrency: 1}
+	downloaded, err := c.DownloadPictures(ctx, mockPictureQuery(), dir)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, downloaded)
+	entries, _ := os.ReadDir(dir)
+	assert.Empty(t, entries)
+}
+
+func TestPictureFileName(t *testing.T) {
+	used := map[string]int{}
+	match := models.RecordingMatch{Channel: 3, Start: time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC), RecordType: models.RecordTypeMotion}
+	assert.Equal(t, "ch03_20240301T083000Z_VideoMotion.jpg", pictureFileName(match, used))
+	assert.Equal(t, "ch03_20240301T083000Z_VideoMotion_2.jpg", pictureFileName(match, used))
+	match.RecordType = ""
+	assert.Equal(t, "ch03_20240301T083000Z_unknown.jpg", pictureFileName(match, used))
+}
@@ -0,0 +1,54 @@
+package annkesdk
+
+import (
+	"fmt"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+func (c Connector) GetPrivacyMask(channel int) (models.PrivacyMask, error) {
+	privacyMask := models.PrivacyMask{}
+	err := c.makeGetRequest(getPrivacyMaskPath(channel), &privacyMask)
+	return privacyMask, err
+}
+
+func (c Connector) GetPrivacyMaskCapabilities(channel int) (models.PrivacyMask, error) {
+	capabilities := models.PrivacyMask{}
+	err := c.makeGetRequest(getPrivacyMaskCapabilitiesPath(channel), &capabilities)
+	return capabilities, err
+}
+
+func (c Connector) UpdatePrivacyMask(channel int, privacyMask models.PrivacyMask) error {
+	capabilities, err := c.GetPrivacyMaskCapabilities(channel)
+	if err != nil && !isNotSupported(err) {
+		return err
+	}
+	if err := validatePrivacyMask(privacyMask, capabilities.PrivacyMaskRegionList.Size); err != nil {
+		return err
+	}
+	return c.makeUpdateRequest(getPrivacyMaskPath(channel), privacyMask)
+}
+
+func validatePrivacyMask(privacyMask models.PrivacyMask, maxRegions int) error {
+	regions := privacyMask.PrivacyMaskRegionList.PrivacyMaskRegion
+	if maxRegions > 0 && len(regions) > maxRegions {
+		return NewAnnkeValidationError("PrivacyMaskRegion", fmt.Sprintf("device supports at most %d regions, got %d", maxRegions, len(regions)))
+	}
+
+	width, height := normalizedScreenLength, normalizedScreenLength
+	if size := privacyMask.NormalizedScreenSize; size != nil {
+		width, height = size.NormalizedScreenWidth, size.NormalizedScreenHeight
+	}
+	for _, region := range regions {
+		coordinates := region.RegionCoordinatesList.RegionCoordinates
+		if len(coordinates) < minRegionCoordinates {
+			return NewAnnkeValidationError("PrivacyMaskRegion", fmt.Sprintf("region %s needs at least %d coordinates", region.ID, minRegionCoordinates))
+		}
+		for _, point := range coordinates {
+			if point.PositionX < 0 || point.PositionX > width || point.PositionY < 0 || point.PositionY > height {
+				return NewAnnkeValidationError("PrivacyMaskRegion", fmt.Sprintf("region %s coordinate (%d,%d) is outside %dx%d", region.ID, point.PositionX, point.PositionY, width, height))
+			}
+		}
+	}
+	return nil
+}
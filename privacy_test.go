@@ -0,0 +1,96 @@
+package annkesdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockPrivacyMask(points ...models.RegionCoordinates) models.PrivacyMask {
+	mask := models.PrivacyMask{Enabled: true}
+	region := models.PrivacyMaskRegion{ID: "1", Enabled: true}
+	region.RegionCoordinatesList.RegionCoordinates = points
+	mask.PrivacyMaskRegionList.PrivacyMaskRegion = []models.PrivacyMaskRegion{region}
+	return mask
+}
+
+func TestValidatePrivacyMask(t *testing.T) {
+	square := []models.RegionCoordinates{
+		{PositionX: 0, PositionY: 0},
+		{PositionX: 0, PositionY: 200},
+		{PositionX: 200, PositionY: 200},
+		{PositionX: 200, PositionY: 0},
+	}
+	tooMany := mockPrivacyMask(square...)
+	tooMany.PrivacyMaskRegionList.PrivacyMaskRegion = append(tooMany.PrivacyMaskRegionList.PrivacyMaskRegion, tooMany.PrivacyMaskRegionList.PrivacyMaskRegion[0])
+
+	cases := []struct {
+		name          string
+		mask          models.PrivacyMask
+		maxRegions    int
+		expectedError string
+	}{
+		{
+			name:       "valid mask",
+			mask:       mockPrivacyMask(square...),
+			maxRegions: 4,
+		},
+		{
+			name:          "too many regions",
+			mask:          tooMany,
+			maxRegions:    1,
+			expectedError: "invalid parameter PrivacyMaskRegion: device supports at most 1 regions, got 2",
+		},
+		{
+			name:          "not a polygon",
+			mask:          mockPrivacyMask(square[:2]...),
+			expectedError: "invalid parameter PrivacyMaskRegion: region 1 needs at least 3 coordinates",
+		},
+		{
+			name:          "outside normalized screen",
+			mask:          mockPrivacyMask(models.RegionCoordinates{PositionX: 1001}, square[1], square[2]),
+			expectedError: "invalid parameter PrivacyMaskRegion: region 1 coordinate (1001,0) is outside 1000x1000",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePrivacyMask(tc.mask, tc.maxRegions)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestConnector_UpdatePrivacyMaskWithoutCapabilities(t *testing.T) {
+	capabilitiesStatus, puts := http.StatusNotFound, 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ISAPI/System/Video/inputs/channels/1/privacyMask/capabilities":
+			w.WriteHeader(capabilitiesStatus)
+			fmt.Fprint(w, "mock-error")
+		case "/ISAPI/System/Video/inputs/channels/1/privacyMask":
+			puts++
+		}
+	}))
+	defer ts.Close()
+	c := Connector{Host: ts.URL[7:]}
+
+	valid := mockPrivacyMask(models.RegionCoordinates{PositionX: 0, PositionY: 0}, models.RegionCoordinates{PositionX: 100, PositionY: 0}, models.RegionCoordinates{PositionX: 100, PositionY: 100})
+	assert.Nil(t, c.UpdatePrivacyMask(1, valid))
+	assert.Equal(t, 1, puts)
+
+	invalid := mockPrivacyMask(models.RegionCoordinates{PositionX: 0, PositionY: 0}, models.RegionCoordinates{PositionX: 100, PositionY: 0}, models.RegionCoordinates{PositionX: 100, PositionY: 5000})
+	assert.EqualError(t, c.UpdatePrivacyMask(1, invalid), "invalid parameter PrivacyMaskRegion: region 1 coordinate (100,5000) is outside 1000x1000")
+	assert.Equal(t, 1, puts)
+
+	capabilitiesStatus = http.StatusInternalServerError
+	assert.ErrorContains(t, c.UpdatePrivacyMask(1, valid), "status: 500 payload: mock-error")
+	assert.Equal(t, 1, puts)
+}
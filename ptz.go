@@ -0,0 +1,83 @@
+package annkesdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+func (c Connector) ContinuousMove(ctx context.Context, channel, pan, tilt, zoom int) error {
+	if err := validatePTZSpeed(pan, tilt, zoom); err != nil {
+		return err
+	}
+	data := models.PTZData{Pan: pan, Tilt: tilt, Zoom: zoom}
+	return c.makeRequest(ctx, "PUT", getPTZPath(channel, "continuous"), data, nil)
+}
+
+func (c Connector) StopPTZ(ctx context.Context, channel int) error {
+	return c.makeRequest(ctx, "PUT", getPTZPath(channel, "continuous"), models.PTZData{}, nil)
+}
+
+func (c Connector) Move(ctx context.Context, channel, pan, tilt, zoom int, duration time.Duration) (err error) {
+	if err := validatePTZSpeed(pan, tilt, zoom); err != nil {
+		return err
+	}
+	defer func() {
+		stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ptzStopTimeout)
+		defer cancel()
+		if stopErr := c.StopPTZ(stopCtx, channel); err == nil {
+			err = stopErr
+		}
+	}()
+	if err := c.ContinuousMove(ctx, channel, pan, tilt, zoom); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (c Connector) MomentaryMove(ctx context.Context, channel, pan, tilt, zoom int, duration time.Duration) error {
+	if err := validatePTZSpeed(pan, tilt, zoom); err != nil {
+		return err
+	}
+	data := models.PTZData{Pan: pan, Tilt: tilt, Zoom: zoom}
+	data.Momentary = &struct {
+		Duration int `xml:"duration"`
+	}{Duration: int(duration.Milliseconds())}
+	return c.makeRequest(ctx, "PUT", getPTZPath(channel, "momentary"), data, nil)
+}
+
+func (c Connector) AbsoluteMove(ctx context.Context, channel int, position models.PTZPosition) error {
+	return c.makeRequest(ctx, "PUT", getPTZPath(channel, "absolute"), models.PTZAbsoluteData{AbsoluteHigh: position}, nil)
+}
+
+func (c Connector) RelativeMove(ctx context.Context, channel, x, y, zoom int) error {
+	data := models.PTZRelativeData{}
+	data.Relative.PositionX = x
+	data.Relative.PositionY = y
+	data.Relative.RelativeZoom = zoom
+	return c.makeRequest(ctx, "PUT", getPTZPath(channel, "relative"), data, nil)
+}
+
+func (c Connector) GetPTZPosition(ctx context.Context, channel int) (models.PTZPosition, error) {
+	status := models.PTZStatus{}
+	err := c.makeRequest(ctx, "GET", getPTZPath(channel, "status"), nil, &status)
+	return status.AbsoluteHigh, err
+}
+
+func validatePTZSpeed(speeds ...int) error {
+	for _, speed := range speeds {
+		if speed < -maxPTZSpeed || speed > maxPTZSpeed {
+			return NewAnnkeValidationError("speed", "must be between -100 and 100")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package annkesdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+func (c Connector) GetPresets(ctx context.Context, channel int) (models.PTZPresetList, error) {
+	presets := models.PTZPresetList{}
+	err := c.makeRequest(ctx, "GET", getPTZPath(channel, ptzPresets), nil, &presets)
+	return presets, err
+}
+
+func (c Connector) GetPreset(ctx context.Context, channel, id int) (models.PTZPreset, error) {
+	preset := models.PTZPreset{}
+	err := c.makeRequest(ctx, "GET", getPTZItemPath(channel, ptzPresets, id), nil, &preset)
+	return preset, err
+}
+
+func (c Connector) SetPreset(ctx context.Context, channel, id int, name string) error {
+	preset := models.PTZPreset{ID: strconv.Itoa(id), PresetName: name, Enabled: true}
+	return c.makeRequest(ctx, "PUT", getPTZItemPath(channel, ptzPresets, id), preset, nil)
+}
+
+func (c Connector) DeletePreset(ctx context.Context, channel, id int) error {
+	return c.makeRequest(ctx, "DELETE", getPTZItemPath(channel, ptzPresets, id), nil, nil)
+}
+
+func (c Connector) GotoPreset(ctx context.Context, channel, id int) error {
+	return c.makeRequest(ctx, "PUT", getPTZItemPath(channel, ptzPresets, id)+"/goto", nil, nil)
+}
+
+func (c Connector) GetPatrols(ctx context.Context, channel int) (models.PTZPatrolList, error) {
+	patrols := models.PTZPatrolList{}
+	err := c.makeRequest(ctx, "GET", getPTZPath(channel, ptzPatrols), nil, &patrols)
+	return patrols, err
+}
+
+func (c Connector) GetPatrol(ctx context.Context, channel, id int) (models.PTZPatrol, error) {
+	patrol := models.PTZPatrol{}
+	err := c.makeRequest(ctx, "GET", getPTZItemPath(channel, ptzPatrols, id), nil, &patrol)
+	return patrol, err
+}
+
+func (c Connector) UpdatePatrol(ctx context.Context, channel, id int, patrol models.PTZPatrol) error {
+	return c.makeRequest(ctx, "PUT", getPTZItemPath(channel, ptzPatrols, id), patrol, nil)
+}
+
+func (c Connector) DeletePatrol(ctx context.Context, channel, id int) error {
+	return c.makeRequest(ctx, "DELETE", getPTZItemPath(channel, ptzPatrols, id), nil, nil)
+}
+
+func (c Connector) StartPatrol(ctx context.Context, channel, id int) error {
+	return c.makeRequest(ctx, "PUT", getPTZItemPath(channel, ptzPatrols, id)+"/start", nil, nil)
+}
+
+func (c Connector) StopPatrol(ctx context.Context, channel, id int) error {
+	return c.makeRequest(ctx, "PUT", getPTZItemPath(channel, ptzPatrols, id)+"/stop", nil, nil)
+}
+
+func (c Connector) RunPattern(ctx context.Context, channel, id int) error {
+	return c.makeRequest(ctx, "PUT", getPTZItemPath(channel, ptzPatterns, id)+"/run", nil, nil)
+}
+
+func (c Connector) StopPattern(ctx context.Context, channel, id int) error {
+	return c.makeRequest(ctx, "PUT", getPTZItemPath(channel, ptzPatterns, id)+"/stop", nil, nil)
+}
+
+func (c Connector) AddPresetTriggerAction(eventType models.EventType, channel, ptzChannel, presetID int) error {
+	trigger, err := c.GetEventTriggerFor(eventType, channel)
+	if err != nil {
+		return err
+	}
+	notifications := trigger.EventTriggerNotificationList.EventTriggerNotification
+	for _, notification := range notifications {
+		action := notification.PTZAction
+		if action != nil && action.ActionName == "preset" && action.PTZChannelID == strconv.Itoa(ptzChannel) && action.ActionNum == presetID {
+			return nil
+		}
+	}
+	trigger.EventTriggerNotificationList.EventTriggerNotification = append(notifications, models.EventTriggerNotification{
+		ID:                 fmt.Sprintf("ptz-%d-%d", ptzChannel, presetID),
+		NotificationMethod: "ptz",
+		PTZAction: &models.PTZAction{
+			PTZChannelID: strconv.Itoa(ptzChannel),
+			ActionName:   "preset",
+			ActionNum:    presetID,
+		},
+	})
+	return c.UpdateEventTriggerFor(eventType, channel, trigger)
+}
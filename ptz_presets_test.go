@@ -0,0 +1,67 @@
+package annkesdk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_PresetAndPatrolPaths(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+	}))
+	defer ts.Close()
+	c := Connector{Host: ts.URL[7:]}
+	ctx := context.Background()
+
+	assert.Nil(t, c.GotoPreset(ctx, 1, 3))
+	assert.Nil(t, c.StartPatrol(ctx, 1, 2))
+	assert.Nil(t, c.StopPatrol(ctx, 1, 2))
+	assert.Nil(t, c.RunPattern(ctx, 1, 4))
+	assert.Equal(t, []string{
+		"PUT /ISAPI/PTZCtrl/channels/1/presets/3/goto",
+		"PUT /ISAPI/PTZCtrl/channels/1/patrols/2/start",
+		"PUT /ISAPI/PTZCtrl/channels/1/patrols/2/stop",
+		"PUT /ISAPI/PTZCtrl/channels/1/patterns/4/run",
+	}, requests)
+}
+
+func TestConnector_AddPresetTriggerAction(t *testing.T) {
+	var updated models.EventTrigger
+	puts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ISAPI/Event/triggers/VMD-1", r.URL.Path)
+		if r.Method == "PUT" {
+			puts++
+			assert.Nil(t, xml.NewDecoder(r.Body).Decode(&updated))
+			return
+		}
+		fmt.Fprint(w, `<EventTrigger><id>VMD-1</id><eventType>VMD</eventType><videoInputChannelID>1</videoInputChannelID><EventTriggerNotificationList>`+
+			`<EventTriggerNotification><id>center</id><notificationMethod>center</notificationMethod></EventTriggerNotification>`+
+			`<EventTriggerNotification><id>ptz-2-1</id><notificationMethod>ptz</notificationMethod><ptzAction><ptzChannelID>2</ptzChannelID><actionName>preset</actionName><actionNum>1</actionNum></ptzAction></EventTriggerNotification>`+
+			`</EventTriggerNotificationList></EventTrigger>`)
+	}))
+	defer ts.Close()
+	c := Connector{Host: ts.URL[7:]}
+
+	assert.Nil(t, c.AddPresetTriggerAction(models.EventTypeVMD, 1, 2, 1))
+	assert.Equal(t, 0, puts)
+
+	assert.Nil(t, c.AddPresetTriggerAction(models.EventTypeVMD, 1, 2, 3))
+	assert.Equal(t, 1, puts)
+	notifications := updated.EventTriggerNotificationList.EventTriggerNotification
+	assert.Len(t, notifications, 3)
+	assert.Equal(t, models.EventTriggerNotification{
+		ID:                 "ptz-2-3",
+		NotificationMethod: "ptz",
+		PTZAction:          &models.PTZAction{PTZChannelID: "2", ActionName: "preset", ActionNum: 3},
+	}, notifications[2])
+	assert.NotEqual(t, notifications[1].ID, notifications[2].ID)
+}
@@ -0,0 +1,92 @@
+package annkesdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_Move(t *testing.T) {
+	var mu sync.Mutex
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ISAPI/PTZCtrl/channels/1/continuous", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+	}))
+	defer ts.Close()
+	c := Connector{Host: ts.URL[7:]}
+
+	cases := []struct {
+		name          string
+		duration      time.Duration
+		cancelAfter   time.Duration
+		expectedError string
+	}{
+		{
+			name:     "stops after duration",
+			duration: 10 * time.Millisecond,
+		},
+		{
+			name:          "stops when context is cancelled",
+			duration:      time.Minute,
+			cancelAfter:   10 * time.Millisecond,
+			expectedError: "context canceled",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			bodies = nil
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.cancelAfter > 0 {
+				time.AfterFunc(tc.cancelAfter, cancel)
+			}
+			err := c.Move(ctx, 1, 50, -20, 0, tc.duration)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, []string{
+				"<PTZData><pan>50</pan><tilt>-20</tilt><zoom>0</zoom></PTZData>",
+				"<PTZData><pan>0</pan><tilt>0</tilt><zoom>0</zoom></PTZData>",
+			}, bodies)
+		})
+	}
+}
+
+func TestConnector_MoveStopsWhenMoveFails(t *testing.T) {
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+		}
+	}))
+	defer ts.Close()
+	c := Connector{Host: ts.URL[7:]}
+
+	err := c.Move(context.Background(), 1, 50, -20, 0, time.Minute)
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{
+		"<PTZData><pan>50</pan><tilt>-20</tilt><zoom>0</zoom></PTZData>",
+		"<PTZData><pan>0</pan><tilt>0</tilt><zoom>0</zoom></PTZData>",
+	}, bodies)
+}
+
+func TestConnector_ContinuousMoveInvalidSpeed(t *testing.T) {
+	c := Connector{Host: "localhost:9999"}
+	err := c.ContinuousMove(context.Background(), 1, 101, 0, 0)
+	assert.EqualError(t, err, "invalid parameter speed: must be between -100 and 100")
+}
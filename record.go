@@ -0,0 +1,135 @@
+package annkesdk
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+var (
+	scheduleWeekdays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+	retentionPattern = regexp.MustCompile(`^P(\d+)D`)
+)
+
+func (c Connector) GetRecordTrack(track int) (models.Track, error) {
+	recordTrack := models.Track{}
+	err := c.makeGetRequest(getRecordTrackPath(track), &recordTrack)
+	return recordTrack, err
+}
+
+func (c Connector) UpdateRecordTrack(track int, recordTrack models.Track) error {
+	return c.makeUpdateRequest(getRecordTrackPath(track), recordTrack)
+}
+
+func (c Connector) GetRecordSchedule(channel int) (models.RecordSchedule, error) {
+	track, err := c.GetRecordTrack(StreamID(channel, MainStream))
+	if err != nil {
+		return models.RecordSchedule{}, err
+	}
+	return trackToRecordSchedule(track)
+}
+
+func (c Connector) UpdateRecordSchedule(channel int, schedule models.RecordSchedule) error {
+	trackID := StreamID(channel, MainStream)
+	track, err := c.GetRecordTrack(trackID)
+	if err != nil {
+		return err
+	}
+	if err := applyRecordSchedule(&track, schedule); err != nil {
+		return err
+	}
+	return c.UpdateRecordTrack(trackID, track)
+}
+
+func trackToRecordSchedule(track models.Track) (models.RecordSchedule, error) {
+	extension := track.CustomExtensionList.CustomExtension
+	schedule := models.RecordSchedule{
+		Enabled:    extension.EnableSchedule,
+		PreRecord:  time.Duration(extension.PreRecordTimeSeconds) * time.Second,
+		PostRecord: time.Duration(extension.PostRecordTimeSeconds) * time.Second,
+	}
+	if match := retentionPattern.FindStringSubmatch(track.Duration); match != nil {
+		schedule.RetentionDays, _ = strconv.Atoi(match[1])
+	}
+
+	for _, block := range track.TrackSchedule.ScheduleBlockList.ScheduleBlock {
+		if block.ScheduleBlockType != scheduleBlockType {
+			continue
+		}
+		for _, action := range block.ScheduleAction {
+			if !action.Actions.Record {
+				continue
+			}
+			day, err := weekdayNumber(action.ScheduleActionStartTime.DayOfWeek)
+			if err != nil {
+				return schedule, err
+			}
+			timeBlock := models.TimeBlock{DayOfWeek: day}
+			timeBlock.TimeRange.BeginTime = action.ScheduleActionStartTime.TimeOfDay
+			timeBlock.TimeRange.EndTime = action.ScheduleActionEndTime.TimeOfDay
+			schedule.Blocks = append(schedule.Blocks, models.RecordScheduleBlock{
+				Mode:      action.Actions.ActionRecordingMode,
+				TimeBlock: timeBlock,
+			})
+		}
+	}
+	return schedule, nil
+}
+
+func applyRecordSchedule(track *models.Track, schedule models.RecordSchedule) error {
+	extension := &track.CustomExtensionList.CustomExtension
+	extension.EnableSchedule = schedule.Enabled
+	extension.PreRecordTimeSeconds = int(schedule.PreRecord / time.Second)
+	extension.PostRecordTimeSeconds = int(schedule.PostRecord / time.Second)
+	if schedule.RetentionDays > 0 {
+		track.Duration = fmt.Sprintf("P%dDT0H", schedule.RetentionDays)
+	}
+
+	actions := make([]models.ScheduleAction, 0, len(schedule.Blocks))
+	for i, block := range schedule.Blocks {
+		day, err := weekdayName(block.TimeBlock.DayOfWeek)
+		if err != nil {
+			return err
+		}
+		action := models.ScheduleAction{ID: i + 1}
+		action.ScheduleActionStartTime = models.ScheduleMoment{DayOfWeek: day, TimeOfDay: block.TimeBlock.TimeRange.BeginTime}
+		action.ScheduleActionEndTime = models.ScheduleMoment{DayOfWeek: day, TimeOfDay: block.TimeBlock.TimeRange.EndTime}
+		action.Actions.Record = true
+		action.Actions.ActionRecordingMode = block.Mode
+		actions = append(actions, action)
+	}
+
+	blocks := &track.TrackSchedule.ScheduleBlockList.ScheduleBlock
+	for i := range *blocks {
+		if (*blocks)[i].ScheduleBlockType == scheduleBlockType {
+			(*blocks)[i].ScheduleAction = actions
+			return nil
+		}
+	}
+	*blocks = append(*blocks, models.ScheduleBlock{
+		ScheduleBlockGUID: "{00000000-0000-0000-0000-000000000000}",
+		ScheduleBlockType: scheduleBlockType,
+		ScheduleAction:    actions,
+	})
+	return nil
+}
+
+func weekdayNumber(name string) (string, error) {
+	for i, weekday := range scheduleWeekdays {
+		if weekday == name {
+			return strconv.Itoa(i + 1), nil
+		}
+	}
+	return "", NewAnnkeValidationError("DayOfWeek", "unknown weekday "+name)
+}
+
+func weekdayName(day string) (string, error) {
+	number, err := strconv.Atoi(day)
+	if err != nil || number < 1 || number > len(scheduleWeekdays) {
+		return "", NewAnnkeValidationError("DayOfWeek", "must be between 1 (Monday) and 7 (Sunday)")
+	}
+	return scheduleWeekdays[number-1], nil
+}
@@ -0,0 +1,87 @@
+package annkesdk
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const mockTrack = `<Track version="1.0" xmlns="http://www.hikvision.com/ver20/XMLSchema"><id>101</id><Channel>1</Channel><Enable>true</Enable><Duration>P30DT0H</Duration><LoopEnable>true</LoopEnable><TrackSchedule><ScheduleBlockList><ScheduleBlock><ScheduleBlockGUID>{00000000-0000-0000-0000-000000000000}</ScheduleBlockGUID><ScheduleBlockType>www.std-cgi.com/racm/schedule/ver10</ScheduleBlockType><ScheduleAction><id>1</id><ScheduleActionStartTime><DayOfWeek>Monday</DayOfWeek><TimeOfDay>00:00:00</TimeOfDay></ScheduleActionStartTime><ScheduleActionEndTime><DayOfWeek>Monday</DayOfWeek><TimeOfDay>24:00:00</TimeOfDay></ScheduleActionEndTime><ScheduleDSTEnable>false</ScheduleDSTEnable><Actions><Record>true</Record><Log>false</Log><SaveImg>false</SaveImg><ActionRecordingMode>CMR</ActionRecordingMode></Actions></ScheduleAction><ScheduleAction><id>2</id><ScheduleActionStartTime><DayOfWeek>Sunday</DayOfWeek><TimeOfDay>08:00:00</TimeOfDay></ScheduleActionStartTime><ScheduleActionEndTime><DayOfWeek>Sunday</DayOfWeek><TimeOfDay>18:00:00</TimeOfDay></ScheduleActionEndTime><ScheduleDSTEnable>false</ScheduleDSTEnable><Actions><Record>true</Record><Log>false</Log><SaveImg>false</SaveImg><ActionRecordingMode>EDR</ActionRecordingMode></Actions></ScheduleAction></ScheduleBlock></ScheduleBlockList></TrackSchedule><CustomExtensionList><CustomExtension><CustomExtensionName>www.hikvision.com/RaCM/trackCustomExt/timing</CustomExtensionName><enableSchedule>true</enableSchedule><SaveAudio>false</SaveAudio><PreRecordTimeSeconds>5</PreRecordTimeSeconds><PostRecordTimeSeconds>30</PostRecordTimeSeconds></CustomExtension></CustomExtensionList></Track>`
+
+func mockTimeBlock(day, begin, end string) models.TimeBlock {
+	block := models.TimeBlock{DayOfWeek: day}
+	block.TimeRange.BeginTime = begin
+	block.TimeRange.EndTime = end
+	return block
+}
+
+func TestTrackToRecordSchedule(t *testing.T) {
+	track := models.Track{}
+	assert.Nil(t, xml.Unmarshal([]byte(mockTrack), &track))
+
+	schedule, err := trackToRecordSchedule(track)
+	assert.Nil(t, err)
+	assert.Equal(t, models.RecordSchedule{
+		Enabled:       true,
+		PreRecord:     5 * time.Second,
+		PostRecord:    30 * time.Second,
+		RetentionDays: 30,
+		Blocks: []models.RecordScheduleBlock{
+			{Mode: models.RecordingModeContinuous, TimeBlock: mockTimeBlock("1", "00:00:00", "24:00:00")},
+			{Mode: models.RecordingModeMotionOrAlarm, TimeBlock: mockTimeBlock("7", "08:00:00", "18:00:00")},
+		},
+	}, schedule)
+}
+
+func TestApplyRecordSchedule(t *testing.T) {
+	track := models.Track{}
+	assert.Nil(t, xml.Unmarshal([]byte(mockTrack), &track))
+	schedule, _ := trackToRecordSchedule(track)
+
+	cleared := models.Track{}
+	assert.Nil(t, applyRecordSchedule(&cleared, schedule))
+	assert.Equal(t, "P30DT0H", cleared.Duration)
+	roundTrip, err := trackToRecordSchedule(cleared)
+	assert.Nil(t, err)
+	assert.Equal(t, schedule, roundTrip)
+
+	schedule.Blocks = []models.RecordScheduleBlock{{Mode: models.RecordingModeMotion, TimeBlock: mockTimeBlock("8", "00:00:00", "01:00:00")}}
+	assert.EqualError(t, applyRecordSchedule(&track, schedule), "invalid parameter DayOfWeek: must be between 1 (Monday) and 7 (Sunday)")
+}
+
+func TestApplyRecordScheduleKeepsOtherBlocks(t *testing.T) {
+	holiday := `<ScheduleBlock><ScheduleBlockGUID>{00000000-0000-0000-0000-000000000001}</ScheduleBlockGUID><ScheduleBlockType>www.hikvision.com/racm/schedule/holiday</ScheduleBlockType><ScheduleAction><id>1</id><ScheduleActionStartTime><DayOfWeek>Monday</DayOfWeek><TimeOfDay>00:00:00</TimeOfDay></ScheduleActionStartTime><ScheduleActionEndTime><DayOfWeek>Monday</DayOfWeek><TimeOfDay>24:00:00</TimeOfDay></ScheduleActionEndTime><ScheduleDSTEnable>false</ScheduleDSTEnable><Actions><Record>true</Record><Log>false</Log><SaveImg>false</SaveImg><ActionRecordingMode>MOTION</ActionRecordingMode></Actions></ScheduleAction></ScheduleBlock>`
+	source := `<SrcDescriptor><SrcGUID>{guid}</SrcGUID><SrcChannel>1</SrcChannel><StreamHint>HD,VGA</StreamHint><SrcDriver>RTSP</SrcDriver><SrcType>H.264-AVC-EXT</SrcType><SrcUrl>rtsp://localhost/PSIA/Streaming/channels/1</SrcUrl><SrcUrlMethods>PSIA</SrcUrlMethods><SrcLogin>admin</SrcLogin></SrcDescriptor>`
+	document := strings.Replace(mockTrack, "</ScheduleBlock></ScheduleBlockList>", "</ScheduleBlock>"+holiday+"</ScheduleBlockList>", 1)
+	document = strings.Replace(document, "<TrackSchedule>", "<ContentType>video</ContentType><CodecType>H.264</CodecType>"+source+"<TrackSchedule>", 1)
+	document = strings.Replace(document, "</Track>", "<IntelligentRecord>false</IntelligentRecord></Track>", 1)
+
+	track := models.Track{}
+	assert.Nil(t, xml.Unmarshal([]byte(document), &track))
+	schedule, err := trackToRecordSchedule(track)
+	assert.Nil(t, err)
+	assert.Len(t, schedule.Blocks, 2)
+
+	schedule.Blocks = schedule.Blocks[:1]
+	assert.Nil(t, applyRecordSchedule(&track, schedule))
+	out, err := xml.Marshal(track)
+	assert.Nil(t, err)
+
+	written := models.Track{}
+	assert.Nil(t, xml.Unmarshal(out, &written))
+	blocks := written.TrackSchedule.ScheduleBlockList.ScheduleBlock
+	assert.Len(t, blocks, 2)
+	assert.Len(t, blocks[0].ScheduleAction, 1)
+	assert.Equal(t, "www.hikvision.com/racm/schedule/holiday", blocks[1].ScheduleBlockType)
+	assert.Equal(t, models.RecordingModeMotion, blocks[1].ScheduleAction[0].Actions.ActionRecordingMode)
+	assert.Equal(t, "video", written.ContentType)
+	assert.Equal(t, "H.264", written.CodecType)
+	assert.Equal(t, "rtsp://localhost/PSIA/Streaming/channels/1", written.SrcDescriptor.SrcUrl)
+	assert.Len(t, written.Other, 1)
+	assert.Equal(t, "IntelligentRecord", written.Other[0].XMLName.Local)
+	assert.Equal(t, "false", written.Other[0].InnerXML)
+}
@@ -0,0 +1,95 @@
+package annkesdk
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RTSPOptions struct {
+	Streams         []int
+	OmitCredentials bool
+}
+
+type RTSPStream struct {
+	ChannelID   string
+	ChannelName string
+	Stream      int
+	StreamID    int
+	Live        string
+	Playback    string
+}
+
+func (c Connector) GetRTSPStreams(opts RTSPOptions) ([]RTSPStream, error) {
+	channels, err := c.GetChannels()
+	if err != nil {
+		return nil, err
+	}
+	port, err := c.GetRTSPPort()
+	if err != nil {
+		return nil, err
+	}
+	streams := opts.Streams
+	if len(streams) == 0 {
+		streams = []int{MainStream, SubStream}
+	}
+
+	rtspStreams := make([]RTSPStream, 0, len(channels.VideoInputChannel)*len(streams))
+	for _, channel := range channels.VideoInputChannel {
+		channelID, err := strconv.Atoi(channel.ID)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing channel id %s %w", channel.ID, err)
+		}
+		for _, stream := range streams {
+			streamID := StreamID(channelID, stream)
+			rtspStreams = append(rtspStreams, RTSPStream{
+				ChannelID:   channel.ID,
+				ChannelName: channel.Name,
+				Stream:      stream,
+				StreamID:    streamID,
+				Live:        c.rtspURL(port, fmt.Sprintf("/Streaming/Channels/%d", streamID), opts.OmitCredentials),
+				Playback:    c.rtspURL(port, fmt.Sprintf("/Streaming/tracks/%d", streamID), opts.OmitCredentials),
+			})
+		}
+	}
+	return rtspStreams, nil
+}
+
+func (c Connector) GetRTSPPort() (int, error) {
+	adminAccesses, err := c.GetAdminAccesses()
+	if err != nil {
+		return 0, err
+	}
+	for _, access := range adminAccesses.AdminAccessProtocol {
+		if strings.EqualFold(access.Protocol, "RTSP") && access.PortNo > 0 {
+			return access.PortNo, nil
+		}
+	}
+	return defaultRTSPPort, nil
+}
+
+func PlaybackURL(playback string, start, end time.Time) string {
+	query := url.Values{}
+	query.Set("starttime", start.UTC().Format(playbackTimeFormat))
+	query.Set("endtime", end.UTC().Format(playbackTimeFormat))
+	return playback + "?" + query.Encode()
+}
+
+func (c Connector) rtspURL(port int, path string, omitCredentials bool) string {
+	host := strings.Trim(c.Host, "[]")
+	if hostname, _, err := net.SplitHostPort(c.Host); err == nil {
+		host = hostname
+	}
+	rtsp := url.URL{
+		Scheme: "rtsp",
+		Host:   net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:   path,
+	}
+	if !omitCredentials {
+		rtsp.User = url.UserPassword(c.User, c.Password)
+	}
+	return rtsp.String()
+}
@@ -0,0 +1,163 @@
+package annkesdk
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+type RecordingQuery struct {
+	Channel     int
+	Stream      int
+	Start       time.Time
+	End         time.Time
+	RecordTypes []models.RecordType
+	PageSize    int
+}
+
+type RecordingIterator struct {
+	ctx         context.Context
+	conn        Connector
+	description models.CMSearchDescription
+	items       []models.SearchMatchItem
+	current     models.RecordingMatch
+	done        bool
+	err         error
+}
+
+func (c Connector) SearchRecordings(ctx context.Context, query RecordingQuery) (*RecordingIterator, error) {
+	if query.Channel <= 0 {
+		return nil, NewAnnkeValidationError("Channel", "must be a positive channel id")
+	}
+	stream := query.Stream
+	if stream == 0 {
+		stream = MainStream
+	}
+	return c.newSearch(ctx, []int{StreamID(query.Channel, stream)}, query.Start, query.End, query.RecordTypes, query.PageSize)
+}
+
+func (c Connector) newSearch(ctx context.Context, tracks []int, start, end time.Time, recordTypes []models.RecordType, pageSize int) (*RecordingIterator, error) {
+	if !end.After(start) {
+		return nil, NewAnnkeValidationError("End", "must be after Start")
+	}
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	searchID, err := newSearchID()
+	if err != nil {
+		return nil, err
+	}
+
+	description := models.CMSearchDescription{
+		Version:    "1.0",
+		SearchID:   searchID,
+		MaxResults: pageSize,
+	}
+	description.TrackList.TrackID = tracks
+	description.TimeSpanList.TimeSpan = []models.TimeSpan{{
+		StartTime: start.UTC().Format(searchTimeFormat),
+		EndTime:   end.UTC().Format(searchTimeFormat),
+	}}
+	if len(recordTypes) > 0 {
+		description.MetadataList = &struct {
+			MetadataDescriptor []string `xml:"metadataDescriptor"`
+		}{}
+		for _, recordType := range recordTypes {
+			description.MetadataList.MetadataDescriptor = append(description.MetadataList.MetadataDescriptor, recordTypeDescriptor+string(recordType))
+		}
+	}
+	return &RecordingIterator{ctx: ctx, conn: c, description: description}, nil
+}
+
+func (it *RecordingIterator) Next() bool {
+	for len(it.items) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetch()
+	}
+	item := it.items[0]
+	it.items = it.items[1:]
+	it.current, it.err = parseSearchMatch(item)
+	return it.err == nil
+}
+
+func (it *RecordingIterator) Item() models.RecordingMatch {
+	return it.current
+}
+
+func (it *RecordingIterator) Err() error {
+	return it.err
+}
+
+func (it *RecordingIterator) All() ([]models.RecordingMatch, error) {
+	matches := []models.RecordingMatch{}
+	for it.Next() {
+		matches = append(matches, it.Item())
+	}
+	return matches, it.Err()
+}
+
+func (it *RecordingIterator) fetch() {
+	result := models.CMSearchResult{}
+	if err := it.conn.makeRequest(it.ctx, "POST", searchPath, it.description, &result); err != nil {
+		it.err = err
+		return
+	}
+	it.items = result.MatchList.SearchMatchItem
+	it.description.SearchResultPosition += len(it.items)
+	if result.ResponseStatusStrg != searchStatusMore || len(it.items) == 0 {
+		it.done = true
+	}
+}
+
+func parseSearchMatch(item models.SearchMatchItem) (models.RecordingMatch, error) {
+	start, err := parseSearchTime(item.TimeSpan.StartTime)
+	if err != nil {
+		return models.RecordingMatch{}, err
+	}
+	end, err := parseSearchTime(item.TimeSpan.EndTime)
+	if err != nil {
+		return models.RecordingMatch{}, err
+	}
+	return models.RecordingMatch{
+		Channel:     item.TrackID / 100,
+		TrackID:     item.TrackID,
+		Start:       start,
+		End:         end,
+		RecordType:  parseRecordType(item.MetadataMatches.MetadataDescriptor),
+		ContentType: item.MediaSegmentDescriptor.ContentType,
+		CodecType:   item.MediaSegmentDescriptor.CodecType,
+		PlaybackURI: item.MediaSegmentDescriptor.PlaybackURI,
+	}, nil
+}
+
+func parseRecordType(descriptor string) models.RecordType {
+	return models.RecordType(descriptor[strings.LastIndex(descriptor, "/")+1:])
+}
+
+func parseSearchTime(value string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return parsed, nil
+	}
+	parsed, err = time.ParseInLocation(searchTimeLocalFormat, value, time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Error parsing search time %s %w", value, err)
+	}
+	return parsed, nil
+}
+
+func newSearchID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return fmt.Sprintf("%X-%X-%X-%X-%X", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16]), nil
+}
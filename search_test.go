@@ -0,0 +1,73 @@
+package annkesdk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockSearchItem(start, end string) string {
+	return fmt.Sprintf("<searchMatchItem><sourceID>{0000}</sourceID><trackID>201</trackID><timeSpan><startTime>%s</startTime><endTime>%s</endTime></timeSpan><mediaSegmentDescriptor><contentType>video</contentType><codecType>H.264-BP</codecType><playbackURI>rtsp://mock/Streaming/tracks/201/?starttime=%s</playbackURI></mediaSegmentDescriptor><metadataMatches><metadataDescriptor>recordType.meta.hikvision.com/VideoMotion</metadataDescriptor></metadataMatches></searchMatchItem>", start, end, start)
+}
+
+func TestConnector_SearchRecordings(t *testing.T) {
+	var positions []int
+	var searchIDs []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, searchPath, r.URL.Path)
+		description := models.CMSearchDescription{}
+		assert.Nil(t, xml.NewDecoder(r.Body).Decode(&description))
+		assert.Equal(t, []int{201}, description.TrackList.TrackID)
+		assert.Equal(t, []string{"//recordType.meta.std-cgi.com/VideoMotion"}, description.MetadataList.MetadataDescriptor)
+		positions = append(positions, description.SearchResultPosition)
+		searchIDs = append(searchIDs, description.SearchID)
+
+		switch description.SearchResultPosition {
+		case 0:
+			fmt.Fprintf(w, "<CMSearchResult><responseStatusStrg>MORE</responseStatusStrg><numOfMatches>2</numOfMatches><matchList>%s%s</matchList></CMSearchResult>",
+				mockSearchItem("2024-03-01T00:00:00Z", "2024-03-01T00:10:00Z"), mockSearchItem("2024-03-01T01:00:00Z", "2024-03-01T01:05:00Z"))
+		default:
+			fmt.Fprintf(w, "<CMSearchResult><responseStatusStrg>OK</responseStatusStrg><numOfMatches>1</numOfMatches><matchList>%s</matchList></CMSearchResult>",
+				mockSearchItem("2024-03-01T02:00:00", "2024-03-01T02:30:00"))
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	it, err := c.SearchRecordings(context.Background(), RecordingQuery{
+		Channel:     2,
+		Start:       start,
+		End:         start.Add(24 * time.Hour),
+		RecordTypes: []models.RecordType{models.RecordTypeMotion},
+		PageSize:    2,
+	})
+	assert.Nil(t, err)
+	matches, err := it.All()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 2}, positions)
+	assert.Equal(t, searchIDs[0], searchIDs[1])
+	assert.Len(t, matches, 3)
+	assert.Equal(t, 2, matches[0].Channel)
+	assert.Equal(t, models.RecordTypeMotion, matches[0].RecordType)
+	assert.Equal(t, start.Add(10*time.Minute), matches[0].End)
+	assert.Equal(t, start.Add(2*time.Hour), matches[2].Start)
+	assert.Equal(t, "rtsp://mock/Streaming/tracks/201/?starttime=2024-03-01T01:00:00Z", matches[1].PlaybackURI)
+}
+
+func TestConnector_SearchRecordingsErrors(t *testing.T) {
+	c := Connector{Host: "localhost:9999"}
+	start := time.Now()
+	_, err := c.SearchRecordings(context.Background(), RecordingQuery{Start: start, End: start.Add(time.Hour)})
+	assert.EqualError(t, err, "invalid parameter Channel: must be a positive channel id")
+	_, err = c.SearchRecordings(context.Background(), RecordingQuery{Channel: 1, Start: start, End: start})
+	assert.EqualError(t, err, "invalid parameter End: must be after Start")
+}
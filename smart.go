@@ -0,0 +1,55 @@
+package annkesdk
+
+import "github.com/csrar/annkeSDK/models"
+
+func (c Connector) GetRegionEntrance(channel int) (models.RegionEntrance, error) {
+	regionEntrance := models.RegionEntrance{}
+	err := c.makeGetRequest(getRegionEntrancePath(channel), &regionEntrance)
+	return regionEntrance, err
+}
+
+func (c Connector) GetRegionEntranceSchedule(channel int) (models.MotionSchedule, error) {
+	return c.GetEventSchedule(models.EventTypeRegionEntrance, channel)
+}
+
+func (c Connector) GetRegionEntranceTrigger(channel int) (models.EventTrigger, error) {
+	return c.GetEventTriggerFor(models.EventTypeRegionEntrance, channel)
+}
+
+func (c Connector) UpdateRegionEntrance(channel int, regionEntrance models.RegionEntrance) error {
+	return c.makeUpdateRequest(getRegionEntrancePath(channel), regionEntrance)
+}
+
+func (c Connector) UpdateRegionEntranceSchedule(channel int, schedule models.MotionSchedule) error {
+	return c.UpdateEventSchedule(models.EventTypeRegionEntrance, channel, schedule)
+}
+
+func (c Connector) UpdateRegionEntranceTrigger(channel int, trigger models.EventTrigger) error {
+	return c.UpdateEventTriggerFor(models.EventTypeRegionEntrance, channel, trigger)
+}
+
+func (c Connector) GetRegionExiting(channel int) (models.RegionExiting, error) {
+	regionExiting := models.RegionExiting{}
+	err := c.makeGetRequest(getRegionExitingPath(channel), &regionExiting)
+	return regionExiting, err
+}
+
+func (c Connector) GetRegionExitingSchedule(channel int) (models.MotionSchedule, error) {
+	return c.GetEventSchedule(models.EventTypeRegionExiting, channel)
+}
+
+func (c Connector) GetRegionExitingTrigger(channel int) (models.EventTrigger, error) {
+	return c.GetEventTriggerFor(models.EventTypeRegionExiting, channel)
+}
+
+func (c Connector) UpdateRegionExiting(channel int, regionExiting models.RegionExiting) error {
+	return c.makeUpdateRequest(getRegionExitingPath(channel), regionExiting)
+}
+
+func (c Connector) UpdateRegionExitingSchedule(channel int, schedule models.MotionSchedule) error {
+	return c.UpdateEventSchedule(models.EventTypeRegionExiting, channel, schedule)
+}
+
+func (c Connector) UpdateRegionExitingTrigger(channel int, trigger models.EventTrigger) error {
+	return c.UpdateEventTriggerFor(models.EventTypeRegionExiting, channel, trigger)
+}
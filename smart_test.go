@@ -0,0 +1,78 @@
+package annkesdk
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+const mockRegionList = `<normalizedScreenSize><normalizedScreenWidth>1000</normalizedScreenWidth><normalizedScreenHeight>1000</normalizedScreenHeight></normalizedScreenSize><%[1]sRegionList><%[1]sRegion><id>1</id><sensitivityLevel>50</sensitivityLevel><RegionCoordinatesList><RegionCoordinates><positionX>100</positionX><positionY>100</positionY></RegionCoordinates><RegionCoordinates><positionX>900</positionX><positionY>100</positionY></RegionCoordinates><RegionCoordinates><positionX>500</positionX><positionY>900</positionY></RegionCoordinates></RegionCoordinatesList><detectionTarget>human</detectionTarget></%[1]sRegion></%[1]sRegionList>`
+
+func TestConnector_RegionDetection(t *testing.T) {
+	var requests []string
+	puts := map[string][]byte{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == "PUT" {
+			puts[r.URL.Path], _ = io.ReadAll(r.Body)
+			return
+		}
+		switch r.URL.Path {
+		case "/ISAPI/Smart/regionEntrance/2":
+			fmt.Fprintf(w, "<RegionEntrance><id>2</id><enabled>false</enabled>"+mockRegionList+"</RegionEntrance>", "RegionEntrance")
+		case "/ISAPI/Smart/regionExiting/2":
+			fmt.Fprintf(w, "<RegionExiting><id>2</id><enabled>true</enabled>"+mockRegionList+"</RegionExiting>", "RegionExiting")
+		case "/ISAPI/Event/schedules/regionExiting/regionExiting_video2":
+			fmt.Fprint(w, "<Schedule><id>regionExiting_video2</id><eventType>regionExiting</eventType></Schedule>")
+		case "/ISAPI/Event/triggers/regionEntrance-2":
+			fmt.Fprint(w, "<EventTrigger><id>regionEntrance-2</id><eventType>regionEntrance</eventType></EventTrigger>")
+		}
+	}))
+	defer ts.Close()
+	c := Connector{Host: ts.URL[7:]}
+
+	entrance, err := c.GetRegionEntrance(2)
+	assert.Nil(t, err)
+	assert.False(t, entrance.Enabled)
+	assert.Equal(t, 1000, entrance.NormalizedScreenSize.NormalizedScreenWidth)
+	regions := entrance.RegionEntranceRegionList.RegionEntranceRegion
+	assert.Len(t, regions, 1)
+	assert.Equal(t, "human", regions[0].DetectionTarget)
+	assert.Equal(t, models.RegionCoordinates{PositionX: 500, PositionY: 900}, regions[0].RegionCoordinatesList.RegionCoordinates[2])
+
+	entrance.Enabled = true
+	assert.Nil(t, c.UpdateRegionEntrance(2, entrance))
+	written := models.RegionEntrance{}
+	assert.Nil(t, xml.Unmarshal(puts["/ISAPI/Smart/regionEntrance/2"], &written))
+	assert.Equal(t, entrance, written)
+
+	exiting, err := c.GetRegionExiting(2)
+	assert.Nil(t, err)
+	assert.True(t, exiting.Enabled)
+	assert.Equal(t, 50, exiting.RegionExitingRegionList.RegionExitingRegion[0].SensitivityLevel)
+	assert.Nil(t, c.UpdateRegionExiting(2, exiting))
+	writtenExiting := models.RegionExiting{}
+	assert.Nil(t, xml.Unmarshal(puts["/ISAPI/Smart/regionExiting/2"], &writtenExiting))
+	assert.Equal(t, exiting, writtenExiting)
+
+	schedule, err := c.GetRegionExitingSchedule(2)
+	assert.Nil(t, err)
+	assert.Equal(t, "regionExiting", schedule.EventType)
+	trigger, err := c.GetRegionEntranceTrigger(2)
+	assert.Nil(t, err)
+	assert.Equal(t, "regionEntrance-2", trigger.ID)
+	assert.Equal(t, []string{
+		"GET /ISAPI/Smart/regionEntrance/2",
+		"PUT /ISAPI/Smart/regionEntrance/2",
+		"GET /ISAPI/Smart/regionExiting/2",
+		"PUT /ISAPI/Smart/regionExiting/2",
+		"GET /ISAPI/Event/schedules/regionExiting/regionExiting_video2",
+		"GET /ISAPI/Event/triggers/regionEntrance-2",
+	}, requests)
+}
@@ -0,0 +1,84 @@
+package annkesdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type SnapshotOptions struct {
+	Stream        int
+	Width         int
+	Height        int
+	Quality       int
+	HeaderTimeout time.Duration
+}
+
+type Snapshot struct {
+	ContentType   string
+	ContentLength int64
+	Body          io.ReadCloser
+}
+
+func (c Connector) GetSnapshot(ctx context.Context, channel int, opts SnapshotOptions) (*Snapshot, error) {
+	if opts.Quality < 0 || opts.Quality > 100 {
+		return nil, NewAnnkeValidationError("Quality", "must be between 1 and 100, or 0 for the device default")
+	}
+	stream := opts.Stream
+	if stream == 0 {
+		stream = MainStream
+	}
+
+	path := getSnapshotPath(channel, stream)
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+	headerTimeout := opts.HeaderTimeout
+	if headerTimeout <= 0 {
+		headerTimeout = time.Duration(timeout) * time.Second
+	}
+	requestCtx, cancel := context.WithCancel(ctx)
+	headerTimer := time.AfterFunc(headerTimeout, cancel)
+	resp, err := c.makeStreamRequest(requestCtx, "GET", path, nil, nil)
+	if !headerTimer.Stop() && ctx.Err() == nil {
+		if err == nil {
+			resp.Body.Close()
+		}
+		cancel()
+		return nil, fmt.Errorf("Error waiting for snapshot response from %s %w", path, context.DeadlineExceeded)
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &Snapshot{
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+		Body:          snapshotBody{ReadCloser: resp.Body, cancel: cancel},
+	}, nil
+}
+
+type snapshotBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b snapshotBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+func (opts SnapshotOptions) query() string {
+	query := url.Values{}
+	if opts.Width > 0 && opts.Height > 0 {
+		query.Set("videoResolutionWidth", strconv.Itoa(opts.Width))
+		query.Set("videoResolutionHeight", strconv.Itoa(opts.Height))
+	}
+	if opts.Quality > 0 {
+		query.Set("quality", strconv.Itoa(opts.Quality))
+	}
+	return query.Encode()
+}
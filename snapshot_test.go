@@ -0,0 +1,69 @@
+package annkesdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_GetSnapshot(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ISAPI/Streaming/channels/202/picture" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, "mock-not-found")
+			return
+		}
+		assert.Equal(t, "quality=80&videoResolutionHeight=480&videoResolutionWidth=640", r.URL.RawQuery)
+		w.Header().Set("Content-Type", "image/jpeg")
+		fmt.Fprint(w, "mock-jpeg")
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	snapshot, err := c.GetSnapshot(context.Background(), 2, SnapshotOptions{Stream: 2, Width: 640, Height: 480, Quality: 80})
+	assert.Nil(t, err)
+	defer snapshot.Body.Close()
+	data, _ := io.ReadAll(snapshot.Body)
+	assert.Equal(t, "image/jpeg", snapshot.ContentType)
+	assert.Equal(t, "mock-jpeg", string(data))
+
+	_, err = c.GetSnapshot(context.Background(), 3, SnapshotOptions{})
+	assert.ErrorContains(t, err, "/ISAPI/Streaming/channels/301/picture status: 404 payload: mock-not-found")
+
+	_, err = c.GetSnapshot(context.Background(), 1, SnapshotOptions{Quality: 101})
+	assert.EqualError(t, err, "invalid parameter Quality: must be between 1 and 100, or 0 for the device default")
+}
+
+func TestConnector_GetSnapshotHeaderTimeout(t *testing.T) {
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ISAPI/Streaming/channels/101/picture" {
+			<-release
+			return
+		}
+		w.Header().Set("Content-Type", "image/jpeg")
+		fmt.Fprint(w, "mock-")
+		w.(http.Flusher).Flush()
+		time.Sleep(50 * time.Millisecond)
+		fmt.Fprint(w, "jpeg")
+	}))
+	defer ts.Close()
+	defer close(release)
+
+	c := Connector{Host: ts.URL[7:]}
+	_, err := c.GetSnapshot(context.Background(), 1, SnapshotOptions{HeaderTimeout: 20 * time.Millisecond})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	snapshot, err := c.GetSnapshot(context.Background(), 2, SnapshotOptions{HeaderTimeout: 20 * time.Millisecond})
+	assert.Nil(t, err)
+	data, err := io.ReadAll(snapshot.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-jpeg", string(data))
+	assert.Nil(t, snapshot.Body.Close())
+}
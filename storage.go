@@ -0,0 +1,60 @@
+package annkesdk
+
+import (
+	"context"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+const ConfirmHDDFormat = "ERASE ALL RECORDINGS"
+
+func (c Connector) GetStorage() (models.Storage, error) {
+	storage := models.Storage{}
+	if err := c.makeGetRequest(storagePath, &storage); err != nil {
+		return storage, err
+	}
+	for i, hdd := range storage.HDDList.HDD {
+		smart := models.SMARTTestStatus{}
+		err := c.makeGetRequest(getHDDActionPath(hdd.ID, "SMARTTest/status"), &smart)
+		if isNotSupported(err) {
+			continue
+		}
+		if err != nil {
+			return storage, err
+		}
+		storage.HDDList.HDD[i].SMART = &smart
+	}
+	return storage, nil
+}
+
+func (c Connector) FormatHDD(ctx context.Context, id string, confirmation string) error {
+	if confirmation != ConfirmHDDFormat {
+		return NewAnnkeValidationError("confirmation", "formatting erases all recordings, pass ConfirmHDDFormat to proceed")
+	}
+	return c.makeRequest(ctx, "PUT", getHDDActionPath(id, "format"), nil, nil)
+}
+
+func (c Connector) GetHDDFormatStatus(ctx context.Context, id string) (models.FormatStatus, error) {
+	status := models.FormatStatus{}
+	err := c.makeRequest(ctx, "GET", getHDDActionPath(id, "formatStatus"), nil, &status)
+	return status, err
+}
+
+func (c Connector) GetDiskQuotas() (models.DiskQuotaList, error) {
+	quotas := models.DiskQuotaList{}
+	err := c.makeGetRequest(quotasPath, &quotas)
+	return quotas, err
+}
+
+func (c Connector) GetDiskQuota(id int) (models.DiskQuota, error) {
+	quota := models.DiskQuota{}
+	err := c.makeGetRequest(getQuotaPath(id), &quota)
+	return quota, err
+}
+
+func (c Connector) UpdateDiskQuota(id int, quota models.DiskQuota) error {
+	if quota.VideoQuotaRatio < 0 || quota.PictureQuotaRatio < 0 || quota.VideoQuotaRatio+quota.PictureQuotaRatio > 100 {
+		return NewAnnkeValidationError("DiskQuota", "video and picture quota ratios must add up to at most 100")
+	}
+	return c.makeUpdateRequest(getQuotaPath(id), quota)
+}
@@ -0,0 +1,63 @@
+package annkesdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnector_GetStorage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case storagePath:
+			fmt.Fprint(w, "<storage><hddList><hdd><id>1</id><status>ok</status><capacity>3815447</capacity><freeSpace>1024</freeSpace><property>RW</property></hdd><hdd><id>2</id><status>smartFailed</status></hdd></hddList></storage>")
+		case "/ISAPI/ContentMgmt/Storage/hdd/1/SMARTTest/status":
+			fmt.Fprint(w, "<SMARTTestStatus><temprature>41</temprature><powerOnDay>730</powerOnDay><selfEvaluaingStatus>ok</selfEvaluaingStatus><allEvaluaingStatus>ok</allEvaluaingStatus></SMARTTestStatus>")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	storage, err := c.GetStorage()
+	assert.Nil(t, err)
+	hdds := storage.HDDList.HDD
+	assert.Len(t, hdds, 2)
+	assert.Equal(t, models.HDDStatusOK, hdds[0].Status)
+	assert.Equal(t, int64(3815447), hdds[0].Capacity)
+	assert.Equal(t, 41, hdds[0].SMART.Temperature)
+	assert.Equal(t, models.HDDStatusSmartFailed, hdds[1].Status)
+	assert.Nil(t, hdds[1].SMART)
+}
+
+func TestConnector_GetStorageSMARTError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case storagePath:
+			fmt.Fprint(w, "<storage><hddList><hdd><id>1</id><status>ok</status></hdd><hdd><id>2</id><status>ok</status></hdd></hddList></storage>")
+		case "/ISAPI/ContentMgmt/Storage/hdd/1/SMARTTest/status":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "<ResponseStatus><statusCode>4</statusCode><subStatusCode>notSupport</subStatusCode></ResponseStatus>")
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, "mock-unauthorized")
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	_, err := c.GetStorage()
+	assert.EqualError(t, err, "received unexpected response from: /ISAPI/ContentMgmt/Storage/hdd/2/SMARTTest/status status: 401 payload: mock-unauthorized")
+}
+
+func TestConnector_FormatHDDRequiresConfirmation(t *testing.T) {
+	c := Connector{Host: "localhost:9999"}
+	err := c.FormatHDD(context.Background(), "1", "yes")
+	assert.EqualError(t, err, "invalid parameter confirmation: formatting erases all recordings, pass ConfirmHDDFormat to proceed")
+}
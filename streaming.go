@@ -0,0 +1,71 @@
+package annkesdk
+
+import (
+	"strconv"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+const (
+	MainStream  = 1
+	SubStream   = 2
+	ThirdStream = 3
+)
+
+func StreamID(channel, stream int) int {
+	return channel*100 + stream
+}
+
+func ParseStreamID(id string) (channel int, stream int, err error) {
+	streamID, err := strconv.Atoi(id)
+	if err != nil || streamID < 100 || streamID%100 == 0 {
+		return 0, 0, NewAnnkeValidationError("id", "not a streaming channel id: "+id)
+	}
+	return streamID / 100, streamID % 100, nil
+}
+
+func (c Connector) GetStreamingChannels() (models.StreamingChannelList, error) {
+	channels := models.StreamingChannelList{}
+	err := c.makeGetRequest(streamingChannels, &channels)
+	return channels, err
+}
+
+func (c Connector) GetStreamingChannel(channel, stream int) (models.StreamingChannel, error) {
+	streamingChannel := models.StreamingChannel{}
+	err := c.makeGetRequest(getStreamingChannelPath(channel, stream), &streamingChannel)
+	return streamingChannel, err
+}
+
+func (c Connector) UpdateStreamingChannel(channel, stream int, streamingChannel models.StreamingChannel) error {
+	return c.makeUpdateRequest(getStreamingChannelPath(channel, stream), streamingChannel)
+}
+
+func (c Connector) GetChannelStreams() (map[string][]models.StreamingChannel, error) {
+	channels, err := c.GetChannels()
+	if err != nil {
+		return nil, err
+	}
+	streamingChannels, err := c.GetStreamingChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make(map[string][]models.StreamingChannel, len(channels.VideoInputChannel))
+	for _, channel := range channels.VideoInputChannel {
+		streams[channel.ID] = nil
+	}
+	for _, streamingChannel := range streamingChannels.StreamingChannel {
+		channelID := streamingChannel.Video.VideoInputChannelID
+		if channelID == "" {
+			channel, _, err := ParseStreamID(streamingChannel.ID)
+			if err != nil {
+				continue
+			}
+			channelID = strconv.Itoa(channel)
+		}
+		if _, ok := streams[channelID]; ok {
+			streams[channelID] = append(streams[channelID], streamingChannel)
+		}
+	}
+	return streams, nil
+}
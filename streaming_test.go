@@ -0,0 +1,84 @@
+package annkesdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStreamID(t *testing.T) {
+	cases := []struct {
+		id              string
+		expectedChannel int
+		expectedStream  int
+		expectedError   string
+	}{
+		{id: "101", expectedChannel: 1, expectedStream: 1},
+		{id: "1602", expectedChannel: 16, expectedStream: 2},
+		{id: "100", expectedError: "invalid parameter id: not a streaming channel id: 100"},
+		{id: "99", expectedError: "invalid parameter id: not a streaming channel id: 99"},
+		{id: "mock", expectedError: "invalid parameter id: not a streaming channel id: mock"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.id, func(t *testing.T) {
+			channel, stream, err := ParseStreamID(tc.id)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedChannel, channel)
+			assert.Equal(t, tc.expectedStream, stream)
+		})
+	}
+}
+
+func TestConnector_GetChannelStreams(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case inputChannelsPath:
+			fmt.Fprint(w, "<VideoInputChannelList><VideoInputChannel><id>1</id></VideoInputChannel><VideoInputChannel><id>2</id></VideoInputChannel></VideoInputChannelList>")
+		case streamingChannels:
+			fmt.Fprint(w, "<StreamingChannelList>"+
+				"<StreamingChannel><id>101</id><Video><videoInputChannelID>1</videoInputChannelID></Video></StreamingChannel>"+
+				"<StreamingChannel><id>102</id><Video></Video></StreamingChannel>"+
+				"<StreamingChannel><id>301</id><Video></Video></StreamingChannel>"+
+				"<StreamingChannel><id>mock</id><Video></Video></StreamingChannel>"+
+				"</StreamingChannelList>")
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	streams, err := c.GetChannelStreams()
+	assert.Nil(t, err)
+	assert.Len(t, streams, 2)
+	assert.Len(t, streams["1"], 2)
+	assert.Equal(t, "102", streams["1"][1].ID)
+	assert.Empty(t, streams["2"])
+}
+
+func TestStreamingChannelCodec(t *testing.T) {
+	channel := models.StreamingChannel{}
+	channel.Video.VideoCodecType = models.VideoCodecH264
+	assert.Equal(t, models.VideoCodecH264, channel.Codec())
+	assert.Nil(t, channel.Video.SmartCodec)
+
+	channel.SetCodec(models.VideoCodecH265Plus)
+	assert.Equal(t, models.VideoCodecH265, channel.Video.VideoCodecType)
+	assert.True(t, channel.Video.SmartCodec.Enabled)
+	assert.Equal(t, models.VideoCodecH265Plus, channel.Codec())
+
+	channel.SetCodec(models.VideoCodecH264)
+	assert.Equal(t, models.VideoCodecH264, channel.Video.VideoCodecType)
+	assert.False(t, channel.Video.SmartCodec.Enabled)
+	assert.Equal(t, models.VideoCodecH264, channel.Codec())
+
+	channel.SetCodec(models.VideoCodecMJPEG)
+	channel.Video.SmartCodec.Enabled = true
+	assert.Equal(t, models.VideoCodecMJPEG, channel.Codec())
+}
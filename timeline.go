@@ -0,0 +1,178 @@
+package annkesdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+)
+
+func (c Connector) GetRecordedDays(ctx context.Context, channel, year int, month time.Month) ([]int, error) {
+	distribution := models.TrackDailyDistribution{}
+	param := models.TrackDailyParam{Year: year, MonthOfYear: int(month)}
+	err := c.makeRequest(ctx, "POST", getDailyDistributionPath(StreamID(channel, MainStream)), param, &distribution)
+	if err != nil {
+		return nil, err
+	}
+	days := []int{}
+	for _, day := range distribution.DayList.Day {
+		if day.Record {
+			days = append(days, day.DayOfMonth)
+		}
+	}
+	return days, nil
+}
+
+func (c Connector) GetRecordingTimeline(ctx context.Context, channel int, start, end time.Time) (models.RecordingTimeline, error) {
+	timeline := models.RecordingTimeline{Channel: channel, Start: start, End: end}
+	if !end.After(start) {
+		return timeline, NewAnnkeValidationError("end", "must be after start")
+	}
+	spans, err := c.recordedSpans(ctx, channel, start, end)
+	if err != nil {
+		return timeline, err
+	}
+
+	intervals := []models.TimeInterval{}
+	for _, span := range spans {
+		it, err := c.SearchRecordings(ctx, RecordingQuery{Channel: channel, Start: span.Start, End: span.End})
+		if err != nil {
+			return timeline, err
+		}
+		for it.Next() {
+			match := it.Item()
+			interval := clipInterval(models.TimeInterval{Start: match.Start, End: match.End}, start, end)
+			if !interval.End.After(interval.Start) {
+				continue
+			}
+			intervals = append(intervals, interval)
+			if match.RecordType != "" && match.RecordType != models.RecordTypeContinuous {
+				timeline.Events = append(timeline.Events, models.EventMarker{TimeInterval: interval, RecordType: match.RecordType})
+			}
+		}
+		if err := it.Err(); err != nil {
+			return timeline, err
+		}
+	}
+
+	timeline.Recorded = mergeIntervals(intervals, timelineMergeTolerance)
+	timeline.Gaps = intervalGaps(timeline.Recorded, start, end)
+	sort.Slice(timeline.Events, func(i, j int) bool {
+		return timeline.Events[i].Start.Before(timeline.Events[j].Start)
+	})
+	return timeline, nil
+}
+
+func (c Connector) GetRecordingTimelines(ctx context.Context, channels []int, start, end time.Time) (map[int]models.RecordingTimeline, error) {
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		errs      []error
+		timelines = make(map[int]models.RecordingTimeline, len(channels))
+		limiter   = c.requestLimiter()
+	)
+	for _, channel := range channels {
+		wg.Add(1)
+		go func(channel int) {
+			defer wg.Done()
+			release, err := limiter.acquire(ctx, c.concurrency())
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+				return
+			}
+			defer release()
+
+			timeline, err := c.GetRecordingTimeline(ctx, channel, start, end)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("Error building timeline for channel %d %w", channel, err))
+				return
+			}
+			timelines[channel] = timeline
+		}(channel)
+	}
+	wg.Wait()
+	return timelines, errors.Join(errs...)
+}
+
+func (c Connector) recordedSpans(ctx context.Context, channel int, start, end time.Time) ([]models.TimeInterval, error) {
+	location := start.Location()
+	first, last := start.AddDate(0, 0, -1), end.AddDate(0, 0, 1)
+	recorded := map[time.Time]bool{}
+	for month := time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, location); month.Before(last); month = month.AddDate(0, 1, 0) {
+		days, err := c.GetRecordedDays(ctx, channel, month.Year(), month.Month())
+		if err != nil {
+			return nil, err
+		}
+		for _, day := range days {
+			recorded[time.Date(month.Year(), month.Month(), day, 0, 0, 0, 0, location)] = true
+		}
+	}
+
+	spans := []models.TimeInterval{}
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, location); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if !recorded[day.AddDate(0, 0, -1)] && !recorded[day] && !recorded[day.AddDate(0, 0, 1)] {
+			continue
+		}
+		span := clipInterval(models.TimeInterval{Start: day, End: day.AddDate(0, 0, 1)}, start, end)
+		if last := len(spans) - 1; last >= 0 && !spans[last].End.Before(span.Start) {
+			spans[last].End = span.End
+			continue
+		}
+		spans = append(spans, span)
+	}
+	return spans, nil
+}
+
+func clipInterval(interval models.TimeInterval, start, end time.Time) models.TimeInterval {
+	if interval.Start.Before(start) {
+		interval.Start = start
+	}
+	if interval.End.After(end) {
+		interval.End = end
+	}
+	return interval
+}
+
+func mergeIntervals(intervals []models.TimeInterval, tolerance time.Duration) []models.TimeInterval {
+	sorted := append([]models.TimeInterval(nil), intervals...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	merged := []models.TimeInterval{}
+	for _, interval := range sorted {
+		if last := len(merged) - 1; last >= 0 && !interval.Start.After(merged[last].End.Add(tolerance)) {
+			if interval.End.After(merged[last].End) {
+				merged[last].End = interval.End
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
+
+func intervalGaps(recorded []models.TimeInterval, start, end time.Time) []models.TimeInterval {
+	gaps := []models.TimeInterval{}
+	cursor := start
+	for _, interval := range recorded {
+		if interval.Start.After(cursor) {
+			gaps = append(gaps, models.TimeInterval{Start: cursor, End: interval.Start})
+		}
+		if interval.End.After(cursor) {
+			cursor = interval.End
+		}
+	}
+	if end.After(cursor) {
+		gaps = append(gaps, models.TimeInterval{Start: cursor, End: end})
+	}
+	return gaps
+}
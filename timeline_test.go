@@ -0,0 +1,91 @@
+package annkesdk
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/csrar/annkeSDK/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockInterval(base time.Time, from, to time.Duration) models.TimeInterval {
+	return models.TimeInterval{Start: base.Add(from), End: base.Add(to)}
+}
+
+func TestMergeIntervals(t *testing.T) {
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	intervals := []models.TimeInterval{
+		mockInterval(base, 2*time.Hour, 3*time.Hour),
+		mockInterval(base, 0, time.Hour),
+		mockInterval(base, time.Hour+time.Second, 90*time.Minute),
+		mockInterval(base, 10*time.Minute, 20*time.Minute),
+	}
+	assert.Equal(t, []models.TimeInterval{
+		mockInterval(base, 0, 90*time.Minute),
+		mockInterval(base, 2*time.Hour, 3*time.Hour),
+	}, mergeIntervals(intervals, 2*time.Second))
+}
+
+func TestIntervalGaps(t *testing.T) {
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name     string
+		recorded []models.TimeInterval
+		expected []models.TimeInterval
+	}{
+		{
+			name:     "nothing recorded",
+			expected: []models.TimeInterval{mockInterval(base, 0, 24*time.Hour)},
+		},
+		{
+			name: "gaps around recordings",
+			recorded: []models.TimeInterval{
+				mockInterval(base, time.Hour, 2*time.Hour),
+				mockInterval(base, 3*time.Hour, 24*time.Hour),
+			},
+			expected: []models.TimeInterval{
+				mockInterval(base, 0, time.Hour),
+				mockInterval(base, 2*time.Hour, 3*time.Hour),
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, intervalGaps(tc.recorded, base, base.Add(24*time.Hour)))
+		})
+	}
+}
+
+func TestConnector_GetRecordingTimelineDeviceZone(t *testing.T) {
+	var months []int
+	searches := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case getDailyDistributionPath(201):
+			param := models.TrackDailyParam{}
+			assert.Nil(t, xml.NewDecoder(r.Body).Decode(&param))
+			months = append(months, param.MonthOfYear)
+			record := param.MonthOfYear == 3
+			fmt.Fprintf(w, "<trackDailyDistribution><dayList><day><id>2</id><dayOfMonth>2</dayOfMonth><record>%t</record></day></dayList></trackDailyDistribution>", record)
+		case searchPath:
+			searches++
+			fmt.Fprintf(w, "<CMSearchResult><responseStatusStrg>OK</responseStatusStrg><numOfMatches>1</numOfMatches><matchList>%s</matchList></CMSearchResult>",
+				mockSearchItem("2024-03-01T20:00:00Z", "2024-03-01T21:00:00Z"))
+		}
+	}))
+	defer ts.Close()
+
+	c := Connector{Host: ts.URL[7:]}
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	timeline, err := c.GetRecordingTimeline(context.Background(), 2, start, start.Add(24*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 3}, months)
+	assert.Equal(t, 1, searches)
+	assert.Equal(t, []models.TimeInterval{mockInterval(start, 20*time.Hour, 21*time.Hour)}, timeline.Recorded)
+	assert.Equal(t, []models.TimeInterval{mockInterval(start, 0, 20*time.Hour), mockInterval(start, 21*time.Hour, 24*time.Hour)}, timeline.Gaps)
+}